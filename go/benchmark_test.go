@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"runtime"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -60,9 +61,9 @@ func BenchmarkInsertSingle(b *testing.B) {
 		b.Run(fmt.Sprintf("sequential-%d", size), func(b *testing.B) {
 			b.ResetTimer()
 			for i := 0; i < b.N; i++ {
-				trie := NewTrie[int]()
+				trie := NewStringTrie[int]()
 				for _, word := range words {
-					trie.Insert(&word, i)
+					InsertString(trie, word, i)
 				}
 				runtime.KeepAlive(trie)
 			}
@@ -80,8 +81,8 @@ func BenchmarkInsertBatch(b *testing.B) {
 		b.Run(fmt.Sprintf("add_word_list-%d", size), func(b *testing.B) {
 			b.ResetTimer()
 			for i := 0; i < b.N; i++ {
-				trie := NewTrie[int]()
-				trie.AddWordList(&words, func(word string) int { return len(word) })
+				trie := NewStringTrie[int]()
+				AddWordList(trie, words, func(word string) int { return len(word) })
 				runtime.KeepAlive(trie)
 			}
 		})
@@ -94,18 +95,18 @@ func BenchmarkLookup(b *testing.B) {
 
 	for _, size := range sizes {
 		words := generateWords(size, "lookup")
-		trie := NewTrie[int]()
+		trie := NewStringTrie[int]()
 
 		// Pre-populate the trie
 		for _, word := range words {
-			trie.Insert(&word, 1)
+			InsertString(trie, word, 1)
 		}
 
 		b.Run(fmt.Sprintf("hit-%d", size), func(b *testing.B) {
 			b.ResetTimer()
 			for i := 0; i < b.N; i++ {
 				for _, word := range words {
-					result, _ := trie.Get(&word)
+					result, _ := GetString(trie, word)
 					runtime.KeepAlive(result)
 				}
 			}
@@ -117,7 +118,7 @@ func BenchmarkLookup(b *testing.B) {
 			b.ResetTimer()
 			for i := 0; i < b.N; i++ {
 				for _, word := range missingWords {
-					result, _ := trie.Get(&word)
+					result, _ := GetString(trie, word)
 					runtime.KeepAlive(result)
 				}
 			}
@@ -131,11 +132,11 @@ func BenchmarkPrefixSearch(b *testing.B) {
 
 	for _, size := range sizes {
 		words := generateRealisticWords(size)
-		trie := NewTrie[int]()
+		trie := NewStringTrie[int]()
 
 		// Pre-populate the trie
 		for _, word := range words {
-			trie.Insert(&word, 1)
+			InsertString(trie, word, 1)
 		}
 
 		prefixes := []string{"app", "test", "user", "web", "nonexistent"}
@@ -144,7 +145,7 @@ func BenchmarkPrefixSearch(b *testing.B) {
 			b.ResetTimer()
 			for i := 0; i < b.N; i++ {
 				for _, prefix := range prefixes {
-					result := trie.PrefixSearch(&prefix)
+					result := PrefixSearchString(trie, prefix)
 					runtime.KeepAlive(result)
 				}
 			}
@@ -155,10 +156,10 @@ func BenchmarkPrefixSearch(b *testing.B) {
 // Benchmark autocomplete with different result limits
 func BenchmarkAutoComplete(b *testing.B) {
 	words := generateRealisticWords(10000)
-	trie := NewTrie[int]()
+	trie := NewStringTrie[int]()
 
 	for _, word := range words {
-		trie.Insert(&word, 1)
+		InsertString(trie, word, 1)
 	}
 
 	prefixes := []string{"app", "test", "user", "data", "nonexistent"}
@@ -169,7 +170,7 @@ func BenchmarkAutoComplete(b *testing.B) {
 			b.ResetTimer()
 			for i := 0; i < b.N; i++ {
 				for _, prefix := range prefixes {
-					results := trie.AutoComplete(&prefix)
+					results := AutoCompleteString(trie, prefix)
 					if len(results) > maxResult {
 						results = results[:maxResult]
 					}
@@ -186,10 +187,10 @@ func BenchmarkAutoCompleteScaling(b *testing.B) {
 
 	for _, size := range sizes {
 		words := generateEnglishLikeWords(size)
-		trie := NewTrie[int]()
+		trie := NewStringTrie[int]()
 
 		for _, word := range words {
-			trie.Insert(&word, 1)
+			InsertString(trie, word, 1)
 		}
 
 		b.Run(fmt.Sprintf("english_like-%d", size), func(b *testing.B) {
@@ -198,7 +199,7 @@ func BenchmarkAutoCompleteScaling(b *testing.B) {
 				// Test common prefix lengths
 				for prefixLen := 1; prefixLen <= 3; prefixLen++ {
 					prefix := "test"[:prefixLen]
-					results := trie.AutoComplete(&prefix)
+					results := AutoCompleteString(trie, prefix)
 					if len(results) > 10 {
 						results = results[:10]
 					}
@@ -221,15 +222,15 @@ func BenchmarkDelete(b *testing.B) {
 			for i := 0; i < b.N; i++ {
 				b.StopTimer()
 				// Setup: create a fresh trie for each iteration
-				trie := NewTrie[int]()
+				trie := NewStringTrie[int]()
 				for _, word := range words {
-					trie.Insert(&word, 1)
+					InsertString(trie, word, 1)
 				}
 				b.StartTimer()
 
 				// Actual benchmark: delete all words
 				for _, word := range words {
-					result := trie.Delete(&word)
+					result := DeleteString(trie, word)
 					runtime.KeepAlive(result)
 				}
 				runtime.KeepAlive(trie)
@@ -241,11 +242,11 @@ func BenchmarkDelete(b *testing.B) {
 // Real-world simulation benchmark
 func BenchmarkRealWorldSimulation(b *testing.B) {
 	words := generateRealisticWords(5000)
-	trie := NewTrie[int]()
+	trie := NewStringTrie[int]()
 
 	// Pre-populate with initial data
 	for _, word := range words[:4000] {
-		trie.Insert(&word, 1)
+		InsertString(trie, word, 1)
 	}
 
 	b.Run("mixed_operations", func(b *testing.B) {
@@ -256,32 +257,130 @@ func BenchmarkRealWorldSimulation(b *testing.B) {
 
 			for _, query := range userQueries {
 				// Autocomplete simulation
-				results := trie.AutoComplete(&query)
+				results := AutoCompleteString(trie, query)
 				if len(results) > 10 {
 					results = results[:10]
 				}
 				runtime.KeepAlive(results)
 
 				// Prefix check simulation
-				hasPrefix := trie.PrefixSearch(&query)
+				hasPrefix := PrefixSearchString(trie, query)
 				runtime.KeepAlive(hasPrefix)
 			}
 
 			// Simulate adding a few new words
 			for j := 0; j < 5; j++ {
 				newWord := fmt.Sprintf("dynamic_word_%d", j)
-				trie.Insert(&newWord, j)
+				InsertString(trie, newWord, j)
 			}
 
 			// Simulate some lookups
 			for _, word := range words[:10] {
-				result, _ := trie.Get(&word)
+				result, _ := GetString(trie, word)
 				runtime.KeepAlive(result)
 			}
 		}
 	})
 }
 
+// BenchmarkRadixCompression reports the node count produced by inserting
+// keys that share a long common prefix followed by a unique suffix (the
+// pathological case for a one-rune-per-node trie), to track the memory win
+// from prefix compression over time.
+func BenchmarkRadixCompression(b *testing.B) {
+	words := generateWords(10000, "word")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		trie := NewStringTrie[int]()
+		b.StartTimer()
+
+		for _, word := range words {
+			InsertString(trie, word, 1)
+		}
+
+		b.StopTimer()
+		b.ReportMetric(float64(trie.nodeCount()), "nodes/op")
+		b.StartTimer()
+	}
+}
+
+// BenchmarkWalkVsPrefixSearchAllocs compares an early-exit existence check
+// built on WalkPrefix against PrefixSearch's full []string materialization,
+// on the 1000-word fixture used elsewhere in this file.
+func BenchmarkWalkVsPrefixSearchAllocs(b *testing.B) {
+	words := generateWords(1000, "word")
+	trie := NewStringTrie[int]()
+	for _, word := range words {
+		InsertString(trie, word, 1)
+	}
+
+	prefix := "word"
+
+	b.Run("PrefixSearch", func(b *testing.B) {
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			results := PrefixSearchString(trie, prefix)
+			runtime.KeepAlive(results)
+		}
+	})
+
+	b.Run("WalkPrefix_existence_check", func(b *testing.B) {
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			found := false
+			WalkPrefixString(trie, prefix, func(_ string, _ *int) error {
+				found = true
+				return StopWalk
+			})
+			runtime.KeepAlive(found)
+		}
+	})
+}
+
+// BenchmarkConcurrentAutoComplete measures SyncTrie.AutoComplete throughput
+// while a background goroutine continuously inserts new keys, the scenario
+// that motivates SyncTrie's copy-on-write Snapshot: readers walking the
+// index shouldn't serialize behind a writer the way they would behind a
+// plain sync.RWMutex over a single shared trie.
+func BenchmarkConcurrentAutoComplete(b *testing.B) {
+	trie := NewSyncTrie[int]()
+	words := generateRealisticWords(10000)
+	for _, word := range words {
+		trie.Insert(word, 1)
+	}
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+				trie.Insert(fmt.Sprintf("dynamic_word_%d", i), i)
+			}
+		}
+	}()
+
+	prefixes := []string{"app", "test", "user", "data"}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		results := trie.AutoComplete(prefixes[i%len(prefixes)])
+		runtime.KeepAlive(results)
+	}
+	b.StopTimer()
+
+	close(stop)
+	wg.Wait()
+}
+
 // Benchmark unicode performance
 func BenchmarkUnicode(b *testing.B) {
 	unicodeWords := []string{
@@ -298,17 +397,17 @@ func BenchmarkUnicode(b *testing.B) {
 	b.Run("unicode_insert", func(b *testing.B) {
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
-			trie := NewTrie[int]()
+			trie := NewStringTrie[int]()
 			for _, word := range extendedUnicode {
-				trie.Insert(&word, 1)
+				InsertString(trie, word, 1)
 			}
 			runtime.KeepAlive(trie)
 		}
 	})
 
-	unicodeTrie := NewTrie[int]()
+	unicodeTrie := NewStringTrie[int]()
 	for _, word := range extendedUnicode {
-		unicodeTrie.Insert(&word, 1)
+		InsertString(unicodeTrie, word, 1)
 	}
 
 	b.Run("unicode_autocomplete", func(b *testing.B) {
@@ -316,7 +415,7 @@ func BenchmarkUnicode(b *testing.B) {
 		b.ResetTimer()
 		for i := 0; i < b.N; i++ {
 			for _, prefix := range prefixes {
-				results := unicodeTrie.AutoComplete(&prefix)
+				results := AutoCompleteString(unicodeTrie, prefix)
 				if len(results) > 10 {
 					results = results[:10]
 				}
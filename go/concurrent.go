@@ -0,0 +1,259 @@
+package trie
+
+import (
+	"cmp"
+	"sync"
+)
+
+// ConcurrentTrie wraps a Trie with a sync.RWMutex: Insert and Delete take
+// the write lock, while Get, PrefixSearch, and FuzzySearch take the read
+// lock. It also supports Snapshot, an immutable point-in-time view of the
+// trie backed by structural sharing rather than a full deep copy - reads
+// against a snapshot never block on, or are blocked by, later writes.
+type ConcurrentTrie[TKey cmp.Ordered, TValue any] struct {
+	mu sync.RWMutex
+
+	trie *Trie[TKey, TValue]
+
+	// version is bumped on every Snapshot call. A node is cloned the first
+	// time a write touches it at a newer version than the one it was
+	// stamped with, so nodes reachable from an older snapshot are never
+	// mutated in place.
+	version uint64
+}
+
+// NewConcurrentTrie creates an empty, concurrency-safe Trie.
+func NewConcurrentTrie[TKey cmp.Ordered, TValue any]() *ConcurrentTrie[TKey, TValue] {
+	trie := NewTrie[TKey, TValue]()
+	trie.root.version = 1
+	return &ConcurrentTrie[TKey, TValue]{trie: trie, version: 1}
+}
+
+// Insert adds a key-value pair under the write lock.
+func (self *ConcurrentTrie[TKey, TValue]) Insert(key []TKey, value TValue) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	self.trie.root = cowInsert(self.trie.root, self.trie.maxPrefixPerNode, key, value, self.version)
+}
+
+// Delete removes a key under the write lock.
+func (self *ConcurrentTrie[TKey, TValue]) Delete(key []TKey) bool {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if len(key) == 0 {
+		return false
+	}
+
+	newRoot, deleted, _ := cowDelete(self.trie.root, self.trie.maxPrefixPerNode, key, self.version)
+	self.trie.root = newRoot
+	return deleted
+}
+
+// Get looks up a key under the read lock.
+func (self *ConcurrentTrie[TKey, TValue]) Get(key []TKey) (*TValue, bool) {
+	self.mu.RLock()
+	defer self.mu.RUnlock()
+	return self.trie.Get(key)
+}
+
+// PrefixSearch returns every key with the given prefix under the read lock.
+func (self *ConcurrentTrie[TKey, TValue]) PrefixSearch(prefix []TKey) [][]TKey {
+	self.mu.RLock()
+	defer self.mu.RUnlock()
+	return self.trie.PrefixSearch(prefix)
+}
+
+// FuzzySearch performs an approximate search under the read lock.
+func (self *ConcurrentTrie[TKey, TValue]) FuzzySearch(query []TKey, maxDistance int) []FuzzyMatch[TKey, TValue] {
+	self.mu.RLock()
+	defer self.mu.RUnlock()
+	return self.trie.FuzzySearch(query, maxDistance)
+}
+
+// AutoComplete returns every key with the given prefix under the read lock.
+func (self *ConcurrentTrie[TKey, TValue]) AutoComplete(prefix []TKey) [][]TKey {
+	self.mu.RLock()
+	defer self.mu.RUnlock()
+	return self.trie.AutoComplete(prefix)
+}
+
+// AddKeyList inserts every key in keys under a single write lock, value
+// generated per key by valueGenerator.
+func (self *ConcurrentTrie[TKey, TValue]) AddKeyList(keys [][]TKey, valueGenerator func([]TKey) TValue) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	for _, key := range keys {
+		self.trie.root = cowInsert(self.trie.root, self.trie.maxPrefixPerNode, key, valueGenerator(key), self.version)
+	}
+}
+
+// Snapshot returns an immutable Trie reflecting the state as of this call.
+// It shares structure with the live trie rather than copying it; later
+// writes clone nodes lazily as they touch them, so the snapshot keeps
+// seeing its own consistent view no matter what happens afterward. This
+// lets a read-heavy workload (e.g. an autocomplete server) walk a
+// consistent snapshot while writes continue concurrently.
+func (self *ConcurrentTrie[TKey, TValue]) Snapshot() *Trie[TKey, TValue] {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	self.version++
+	return &Trie[TKey, TValue]{
+		root:             self.trie.root,
+		maxPrefixPerNode: self.trie.maxPrefixPerNode,
+	}
+}
+
+// cloneNodeForVersion returns node unchanged if it already belongs to
+// version v, otherwise returns a shallow clone (children pointers copied,
+// not the children themselves) stamped with v - any snapshot still holding
+// the original node is left untouched.
+func cloneNodeForVersion[TKey cmp.Ordered, TValue any](node *TrieNode[TKey, TValue], v uint64) *TrieNode[TKey, TValue] {
+	if node.version == v {
+		return node
+	}
+
+	clone := &TrieNode[TKey, TValue]{
+		prefix:  append([]TKey(nil), node.prefix...),
+		value:   node.value,
+		version: v,
+	}
+	node.children.forEach(func(sym TKey, child *TrieNode[TKey, TValue]) {
+		clone.children.set(sym, child)
+	})
+	return clone
+}
+
+// cowInsert mirrors Trie.insertSymbols, but clones nodes along the mutated
+// path (via cloneNodeForVersion) instead of mutating them in place, and
+// returns the node the caller should store in place of the one it passed in.
+func cowInsert[TKey cmp.Ordered, TValue any](node *TrieNode[TKey, TValue], maxPrefixPerNode int, remaining []TKey, value TValue, v uint64) *TrieNode[TKey, TValue] {
+	node = cloneNodeForVersion(node, v)
+
+	if len(remaining) == 0 {
+		node.setValue(value)
+		return node
+	}
+
+	child, err := node.getChildMut(remaining[0])
+	if err != nil {
+		node.children.set(remaining[0], newChainForInsert[TKey, TValue](maxPrefixPerNode, remaining, value, v))
+		return node
+	}
+
+	common := commonPrefixLen(child.prefix, remaining)
+	if common == len(child.prefix) {
+		node.children.set(remaining[0], cowInsert(child, maxPrefixPerNode, remaining[common:], value, v))
+		return node
+	}
+
+	sharedPrefix := append([]TKey(nil), child.prefix[:common]...)
+	suffix := append([]TKey(nil), child.prefix[common:]...)
+
+	clonedChild := cloneNodeForVersion(child, v)
+	clonedChild.prefix = suffix
+
+	splitNode := &TrieNode[TKey, TValue]{prefix: sharedPrefix, version: v}
+	splitNode.children.set(suffix[0], clonedChild)
+
+	node.children.set(sharedPrefix[0], cowInsert(splitNode, maxPrefixPerNode, remaining[common:], value, v))
+	return node
+}
+
+// newChainForInsert builds a brand-new chain of nodes (no cloning needed,
+// since nothing references them yet) to hold remaining, chunked to at most
+// maxPrefixPerNode symbols per node.
+func newChainForInsert[TKey cmp.Ordered, TValue any](maxPrefixPerNode int, remaining []TKey, value TValue, v uint64) *TrieNode[TKey, TValue] {
+	chunk := remaining
+	if len(chunk) > maxPrefixPerNode {
+		chunk = chunk[:maxPrefixPerNode]
+	}
+
+	node := &TrieNode[TKey, TValue]{prefix: append([]TKey(nil), chunk...), version: v}
+	rest := remaining[len(chunk):]
+	if len(rest) == 0 {
+		node.setValue(value)
+		return node
+	}
+
+	node.children.set(rest[0], newChainForInsert[TKey, TValue](maxPrefixPerNode, rest, value, v))
+	return node
+}
+
+// cowDelete mirrors Trie.deleteRecursive, cloning nodes along the mutated
+// path instead of mutating them in place. It returns the (possibly new)
+// node to store in the parent, whether a key was deleted, and whether the
+// returned node is now empty and can be pruned by the caller.
+func cowDelete[TKey cmp.Ordered, TValue any](node *TrieNode[TKey, TValue], maxPrefixPerNode int, remaining []TKey, v uint64) (*TrieNode[TKey, TValue], bool, bool) {
+	if len(remaining) == 0 {
+		if !node.isEndOfWord() {
+			return node, false, false
+		}
+		node = cloneNodeForVersion(node, v)
+		node.clearValue()
+		return node, true, !node.hasChildren()
+	}
+
+	child, err := node.getChildMut(remaining[0])
+	if err != nil || len(remaining) < len(child.prefix) || !symbolsEqual(child.prefix, remaining[:len(child.prefix)]) {
+		return node, false, false
+	}
+
+	updatedChild, deleted, shouldRemoveChild := cowDelete(child, maxPrefixPerNode, remaining[len(child.prefix):], v)
+	if !deleted {
+		return node, false, false
+	}
+
+	node = cloneNodeForVersion(node, v)
+	if shouldRemoveChild {
+		node.removeChild(remaining[0])
+	} else {
+		node.children.set(remaining[0], cowMergeIfPossible(updatedChild, maxPrefixPerNode, v))
+	}
+
+	return node, true, !node.isEndOfWord() && !node.hasChildren()
+}
+
+// cowMergeIfPossible mirrors Trie.mergeIfPossible, cloning node before
+// collapsing it into its sole remaining child.
+func cowMergeIfPossible[TKey cmp.Ordered, TValue any](node *TrieNode[TKey, TValue], maxPrefixPerNode int, v uint64) *TrieNode[TKey, TValue] {
+	if node.isEndOfWord() || node.children.len() != 1 {
+		return node
+	}
+
+	var onlyChild *TrieNode[TKey, TValue]
+	node.children.forEach(func(_ TKey, child *TrieNode[TKey, TValue]) {
+		onlyChild = child
+	})
+
+	if len(node.prefix)+len(onlyChild.prefix) > maxPrefixPerNode {
+		return node
+	}
+
+	node = cloneNodeForVersion(node, v)
+	node.prefix = append(node.prefix, onlyChild.prefix...)
+	node.children = childSet[TKey, TValue]{}
+	onlyChild.children.forEach(func(sym TKey, child *TrieNode[TKey, TValue]) {
+		node.children.set(sym, child)
+	})
+	node.value = onlyChild.value
+	return node
+}
+
+// NewConcurrentStringTrie creates an empty, concurrency-safe Trie keyed by
+// rune, for text keys.
+func NewConcurrentStringTrie[TValue any]() *ConcurrentTrie[rune, TValue] {
+	return NewConcurrentTrie[rune, TValue]()
+}
+
+// InsertStringConcurrent inserts a string key into a rune-keyed
+// ConcurrentTrie.
+func InsertStringConcurrent[TValue any](t *ConcurrentTrie[rune, TValue], key string, value TValue) {
+	t.Insert([]rune(key), value)
+}
+
+// GetStringConcurrent looks up a string key in a rune-keyed ConcurrentTrie.
+func GetStringConcurrent[TValue any](t *ConcurrentTrie[rune, TValue], key string) (*TValue, bool) {
+	return t.Get([]rune(key))
+}
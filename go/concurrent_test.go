@@ -0,0 +1,141 @@
+package trie
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentTrieInsertAndGet(t *testing.T) {
+	trie := NewConcurrentStringTrie[int]()
+
+	key := "apple"
+	InsertStringConcurrent(trie, key, 42)
+
+	value, exists := GetStringConcurrent(trie, key)
+	if !exists || value == nil || *value != 42 {
+		t.Errorf("Expected apple=42, got value=%v exists=%v", value, exists)
+	}
+}
+
+func TestConcurrentTrieDelete(t *testing.T) {
+	trie := NewConcurrentStringTrie[int]()
+
+	words := []string{"cat", "cats", "car"}
+	for i, word := range words {
+		InsertStringConcurrent(trie, word, i)
+	}
+
+	key := "cat"
+	if !trie.Delete([]rune(key)) {
+		t.Error("Delete should return true for existing key")
+	}
+
+	if _, exists := GetStringConcurrent(trie, key); exists {
+		t.Error("Deleted key should not exist")
+	}
+
+	for _, word := range []string{"cats", "car"} {
+		if _, exists := GetStringConcurrent(trie, word); !exists {
+			t.Errorf("Key %s should still exist after deleting %s", word, key)
+		}
+	}
+}
+
+func TestConcurrentTrieParallelReadWrite(t *testing.T) {
+	trie := NewConcurrentStringTrie[int]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			word := fmt.Sprintf("word_%d", i)
+			InsertStringConcurrent(trie, word, i)
+		}(i)
+	}
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			word := fmt.Sprintf("word_%d", i)
+			GetStringConcurrent(trie, word)
+			trie.PrefixSearch([]rune(word))
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i := 0; i < 50; i++ {
+		word := fmt.Sprintf("word_%d", i)
+		value, exists := GetStringConcurrent(trie, word)
+		if !exists || value == nil || *value != i {
+			t.Errorf("Expected %s=%d after concurrent inserts, got value=%v exists=%v", word, i, value, exists)
+		}
+	}
+}
+
+func TestConcurrentTrieSnapshotIsolation(t *testing.T) {
+	trie := NewConcurrentStringTrie[int]()
+
+	key := "apple"
+	InsertStringConcurrent(trie, key, 1)
+
+	snapshot := trie.Snapshot()
+
+	// Mutate the live trie after taking the snapshot.
+	InsertStringConcurrent(trie, key, 2)
+	newKey := "banana"
+	InsertStringConcurrent(trie, newKey, 3)
+	trie.Delete([]rune(key))
+
+	// The snapshot should still see the world as it was when it was taken.
+	value, exists := GetString(snapshot, key)
+	if !exists || value == nil || *value != 1 {
+		t.Errorf("Snapshot should still see apple=1, got value=%v exists=%v", value, exists)
+	}
+
+	if _, exists := GetString(snapshot, newKey); exists {
+		t.Error("Snapshot should not see keys inserted after it was taken")
+	}
+
+	// The live trie should reflect the later mutations.
+	if _, exists := GetStringConcurrent(trie, key); exists {
+		t.Error("Live trie should reflect the later delete of apple")
+	}
+
+	value, exists = GetStringConcurrent(trie, newKey)
+	if !exists || value == nil || *value != 3 {
+		t.Errorf("Live trie should see banana=3, got value=%v exists=%v", value, exists)
+	}
+}
+
+func TestConcurrentTrieSnapshotSurvivesManyWrites(t *testing.T) {
+	trie := NewConcurrentStringTrie[int]()
+
+	words := make([]string, 200)
+	for i := range words {
+		words[i] = fmt.Sprintf("prefix_%d", i)
+		InsertStringConcurrent(trie, words[i], i)
+	}
+
+	snapshot := trie.Snapshot()
+
+	for i := range words {
+		trie.Delete([]rune(words[i]))
+	}
+
+	for i, word := range words {
+		value, exists := GetString(snapshot, word)
+		if !exists || value == nil || *value != i {
+			t.Errorf("Snapshot should still see %s=%d after live trie deleted everything, got value=%v exists=%v", word, i, value, exists)
+		}
+	}
+
+	for _, word := range words {
+		if _, exists := GetStringConcurrent(trie, word); exists {
+			t.Errorf("Live trie should no longer have %s", word)
+		}
+	}
+}
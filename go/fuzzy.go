@@ -0,0 +1,148 @@
+package trie
+
+// FuzzyMatch describes a trie entry found by an approximate search, along
+// with its edit distance from the query that found it.
+type FuzzyMatch[TKey any, TValue any] struct {
+	Key      []TKey
+	Value    *TValue
+	Distance int
+}
+
+// FuzzySearch returns every stored key within maxDistance Levenshtein edits
+// of query. The search computes the standard edit-distance DP incrementally
+// over the trie: each visited node derives a new row from its parent's row
+// (one column per query symbol) instead of recomputing the whole table, and
+// the recursion is pruned as soon as a row's minimum entry already exceeds
+// maxDistance, since no extension of that prefix can do better.
+func (self *Trie[TKey, TValue]) FuzzySearch(query []TKey, maxDistance int) []FuzzyMatch[TKey, TValue] {
+	return self.fuzzySearch(query, maxDistance, false)
+}
+
+// FuzzyPrefixSearch is like FuzzySearch, but also matches a stored key once
+// query is within maxDistance of any prefix of that key (checked at each
+// node boundary along the key's path), rather than requiring the whole key
+// to be close to query. This is useful for autocompleting misspelled input:
+// every key reachable once a node qualifies is reported, tagged with the
+// distance measured at that node.
+func (self *Trie[TKey, TValue]) FuzzyPrefixSearch(query []TKey, maxDistance int) []FuzzyMatch[TKey, TValue] {
+	return self.fuzzySearch(query, maxDistance, true)
+}
+
+func (self *Trie[TKey, TValue]) fuzzySearch(query []TKey, maxDistance int, prefixMode bool) []FuzzyMatch[TKey, TValue] {
+	results := []FuzzyMatch[TKey, TValue]{}
+
+	initialRow := make([]int, len(query)+1)
+	for i := range initialRow {
+		initialRow[i] = i
+	}
+
+	self.fuzzyRecurse(self.root, nil, query, initialRow, maxDistance, prefixMode, &results)
+	return results
+}
+
+func (self *Trie[TKey, TValue]) fuzzyRecurse(node *TrieNode[TKey, TValue], keySoFar []TKey, query []TKey, prevRow []int, maxDistance int, prefixMode bool, results *[]FuzzyMatch[TKey, TValue]) {
+	row := prevRow
+	for _, edgeSym := range node.prefix {
+		row = nextLevenshteinRow(row, query, edgeSym)
+	}
+	keySoFar = append(append([]TKey(nil), keySoFar...), node.prefix...)
+
+	if minRow(row) > maxDistance {
+		// No extension of keySoFar can bring the distance back under
+		// maxDistance, so there's nothing more to find down this path.
+		return
+	}
+
+	distance := row[len(query)]
+
+	if prefixMode && distance <= maxDistance {
+		self.collectCompletions(node, keySoFar, distance, results)
+		return
+	}
+
+	if node.isEndOfWord() && distance <= maxDistance {
+		*results = append(*results, FuzzyMatch[TKey, TValue]{Key: keySoFar, Value: node.value, Distance: distance})
+	}
+
+	node.children.forEach(func(_ TKey, child *TrieNode[TKey, TValue]) {
+		self.fuzzyRecurse(child, keySoFar, query, row, maxDistance, prefixMode, results)
+	})
+}
+
+// collectCompletions appends every terminal in node's subtree as a
+// FuzzyMatch carrying the given distance, used by FuzzyPrefixSearch once a
+// node's accumulated key is already within maxDistance of the query.
+func (self *Trie[TKey, TValue]) collectCompletions(node *TrieNode[TKey, TValue], keySoFar []TKey, distance int, results *[]FuzzyMatch[TKey, TValue]) {
+	if node.isEndOfWord() {
+		*results = append(*results, FuzzyMatch[TKey, TValue]{Key: keySoFar, Value: node.value, Distance: distance})
+	}
+	node.children.forEach(func(_ TKey, child *TrieNode[TKey, TValue]) {
+		self.collectCompletions(child, append(append([]TKey(nil), keySoFar...), child.prefix...), distance, results)
+	})
+}
+
+// nextLevenshteinRow derives the DP row for matching query against a key
+// that ends in edgeSym, given the row for the key without that symbol.
+func nextLevenshteinRow[TKey comparable](prevRow []int, query []TKey, edgeSym TKey) []int {
+	row := make([]int, len(prevRow))
+	row[0] = prevRow[0] + 1
+
+	for j := 1; j < len(row); j++ {
+		deleteCost := prevRow[j] + 1
+		insertCost := row[j-1] + 1
+		substituteCost := prevRow[j-1]
+		if query[j-1] != edgeSym {
+			substituteCost++
+		}
+		row[j] = minOfThree(deleteCost, insertCost, substituteCost)
+	}
+
+	return row
+}
+
+func minRow(row []int) int {
+	min := row[0]
+	for _, v := range row[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return min
+}
+
+func minOfThree(a, b, c int) int {
+	min := a
+	if b < min {
+		min = b
+	}
+	if c < min {
+		min = c
+	}
+	return min
+}
+
+// FuzzyStringMatch is FuzzyMatch with the key rendered back to a string, as
+// returned by FuzzySearchString and FuzzyPrefixSearchString.
+type FuzzyStringMatch[TValue any] struct {
+	Key      string
+	Value    *TValue
+	Distance int
+}
+
+func toFuzzyStringMatches[TValue any](matches []FuzzyMatch[rune, TValue]) []FuzzyStringMatch[TValue] {
+	results := make([]FuzzyStringMatch[TValue], len(matches))
+	for i, m := range matches {
+		results[i] = FuzzyStringMatch[TValue]{Key: string(m.Key), Value: m.Value, Distance: m.Distance}
+	}
+	return results
+}
+
+// FuzzySearchString is FuzzySearch for a rune-keyed Trie.
+func FuzzySearchString[TValue any](t *Trie[rune, TValue], query string, maxDistance int) []FuzzyStringMatch[TValue] {
+	return toFuzzyStringMatches(t.FuzzySearch([]rune(query), maxDistance))
+}
+
+// FuzzyPrefixSearchString is FuzzyPrefixSearch for a rune-keyed Trie.
+func FuzzyPrefixSearchString[TValue any](t *Trie[rune, TValue], query string, maxDistance int) []FuzzyStringMatch[TValue] {
+	return toFuzzyStringMatches(t.FuzzyPrefixSearch([]rune(query), maxDistance))
+}
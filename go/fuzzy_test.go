@@ -0,0 +1,134 @@
+package trie
+
+import (
+	"sort"
+	"testing"
+)
+
+func insertFuzzyFixture(trie *Trie[rune, int]) {
+	words := []string{"cat", "car", "care", "careful", "careless"}
+	for i, word := range words {
+		InsertString(trie, word, i)
+	}
+}
+
+func fuzzyKeys(matches []FuzzyStringMatch[int]) []string {
+	keys := make([]string, len(matches))
+	for i, m := range matches {
+		keys[i] = m.Key
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestFuzzySearchExactDistance0(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertFuzzyFixture(trie)
+
+	query := "care"
+	matches := FuzzySearchString(trie, query, 0)
+
+	if len(matches) != 1 || matches[0].Key != "care" || matches[0].Distance != 0 {
+		t.Errorf("Expected exactly [care] at distance 0, got %+v", matches)
+	}
+}
+
+func TestFuzzySearchDistance1(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertFuzzyFixture(trie)
+
+	// "cars" is one substitution away from "care" and one insertion from "car".
+	query := "cars"
+	matches := FuzzySearchString(trie, query, 1)
+
+	keys := fuzzyKeys(matches)
+	expected := []string{"car", "care"}
+	sort.Strings(expected)
+
+	if len(keys) != len(expected) {
+		t.Errorf("Expected %v, got %v", expected, keys)
+	}
+	for _, k := range expected {
+		found := false
+		for _, got := range keys {
+			if got == k {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected %q in fuzzy matches, got %v", k, keys)
+		}
+	}
+}
+
+func TestFuzzySearchDistance2(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertFuzzyFixture(trie)
+
+	query := "carefull" // one extra 'l' from "careful"
+	matches := FuzzySearchString(trie, query, 2)
+
+	foundCareful := false
+	for _, m := range matches {
+		if m.Key == "careful" {
+			foundCareful = true
+			if m.Distance != 1 {
+				t.Errorf("Expected distance 1 for careful, got %d", m.Distance)
+			}
+		}
+	}
+	if !foundCareful {
+		t.Errorf("Expected careful within distance 2 of %q, got %+v", query, matches)
+	}
+}
+
+func TestFuzzySearchEmptyQuery(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertFuzzyFixture(trie)
+
+	query := ""
+	matches := FuzzySearchString(trie, query, 2)
+
+	// Only keys of length <= maxDistance can match an empty query.
+	for _, m := range matches {
+		if len([]rune(m.Key)) > 2 {
+			t.Errorf("Key %q should not match empty query within distance 2", m.Key)
+		}
+	}
+}
+
+func TestFuzzySearchPruning(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertFuzzyFixture(trie)
+
+	// "xyz" is far from everything in the "care*" fixture; with a tight
+	// maxDistance, pruning should leave no matches rather than walking the
+	// whole tree.
+	query := "xyz"
+	matches := FuzzySearchString(trie, query, 1)
+
+	if len(matches) != 0 {
+		t.Errorf("Expected no matches for distant query, got %+v", matches)
+	}
+}
+
+func TestFuzzyPrefixSearch(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertFuzzyFixture(trie)
+
+	// "car" is an exact prefix of "careful" and "careless"; searching for
+	// "car" with FuzzyPrefixSearch should surface those completions too.
+	query := "car"
+	matches := FuzzyPrefixSearchString(trie, query, 0)
+
+	keys := fuzzyKeys(matches)
+	expectedAny := map[string]bool{"car": true, "care": true, "careful": true, "careless": true}
+	if len(keys) == 0 {
+		t.Fatal("Expected at least one fuzzy-prefix completion")
+	}
+	for _, k := range keys {
+		if !expectedAny[k] {
+			t.Errorf("Unexpected fuzzy-prefix completion %q", k)
+		}
+	}
+}
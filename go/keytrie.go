@@ -0,0 +1,89 @@
+package trie
+
+// keytrie.go provides thin convenience wrappers around the generic
+// Trie[TKey, TValue] for the two alphabets nearly every caller actually
+// wants: runes (for ordinary text keys) and bytes (for binary-oriented keys
+// like DNS labels, protocol fields, or interned []byte identifiers). Go
+// doesn't allow specializing a method for one instantiation of a generic
+// type, so these are free functions rather than methods.
+
+// NewStringTrie creates an empty Trie keyed by rune, for text keys.
+func NewStringTrie[TValue any]() *Trie[rune, TValue] {
+	return NewTrie[rune, TValue]()
+}
+
+// InsertString inserts a string key into a rune-keyed Trie.
+func InsertString[TValue any](t *Trie[rune, TValue], key string, value TValue) {
+	t.Insert([]rune(key), value)
+}
+
+// GetString looks up a string key in a rune-keyed Trie.
+func GetString[TValue any](t *Trie[rune, TValue], key string) (*TValue, bool) {
+	return t.Get([]rune(key))
+}
+
+// DeleteString removes a string key from a rune-keyed Trie.
+func DeleteString[TValue any](t *Trie[rune, TValue], key string) bool {
+	if key == "" {
+		return false
+	}
+	return t.Delete([]rune(key))
+}
+
+// PrefixSearchString returns every key in a rune-keyed Trie that starts
+// with prefix.
+func PrefixSearchString[TValue any](t *Trie[rune, TValue], prefix string) []string {
+	matches := t.PrefixSearch([]rune(prefix))
+	results := make([]string, len(matches))
+	for i, match := range matches {
+		results[i] = string(match)
+	}
+	return results
+}
+
+// AutoCompleteString is PrefixSearchString under the name used by
+// Trie.AutoComplete.
+func AutoCompleteString[TValue any](t *Trie[rune, TValue], prefix string) []string {
+	return PrefixSearchString(t, prefix)
+}
+
+// GetByPrefixString is GetByPrefix for a rune-keyed Trie, converting the
+// resolved key back to a string.
+func GetByPrefixString[TValue any](t *Trie[rune, TValue], prefix string) (string, *TValue, error) {
+	key, value, err := t.GetByPrefix([]rune(prefix))
+	if err != nil {
+		return "", nil, err
+	}
+	return string(key), value, nil
+}
+
+// AddWordList takes a list of words and a function that generates a value
+// for each word to store at the end of its chain.
+func AddWordList[TValue any](t *Trie[rune, TValue], words []string, valueGenerator func(string) TValue) {
+	for _, word := range words {
+		InsertString(t, word, valueGenerator(word))
+	}
+}
+
+// NewByteTrie creates an empty Trie keyed by byte, for binary keys.
+func NewByteTrie[TValue any]() *Trie[byte, TValue] {
+	return NewTrie[byte, TValue]()
+}
+
+// InsertBytes inserts a []byte key into a byte-keyed Trie.
+func InsertBytes[TValue any](t *Trie[byte, TValue], key []byte, value TValue) {
+	t.Insert(key, value)
+}
+
+// GetBytes looks up a []byte key in a byte-keyed Trie.
+func GetBytes[TValue any](t *Trie[byte, TValue], key []byte) (*TValue, bool) {
+	return t.Get(key)
+}
+
+// DeleteBytes removes a []byte key from a byte-keyed Trie.
+func DeleteBytes[TValue any](t *Trie[byte, TValue], key []byte) bool {
+	if len(key) == 0 {
+		return false
+	}
+	return t.Delete(key)
+}
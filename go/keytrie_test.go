@@ -0,0 +1,92 @@
+package trie
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func sortByteKeys(keys [][]byte) []string {
+	strs := make([]string, len(keys))
+	for i, key := range keys {
+		strs[i] = string(key)
+	}
+	sort.Strings(strs)
+	return strs
+}
+
+func TestByteTrieInsertAndGet(t *testing.T) {
+	trie := NewByteTrie[int]()
+
+	labels := map[string]int{
+		"api.example.com":  1,
+		"www.example.com":  2,
+		"mail.example.com": 3,
+	}
+	for label, value := range labels {
+		InsertBytes(trie, []byte(label), value)
+	}
+
+	for label, want := range labels {
+		got, exists := GetBytes(trie, []byte(label))
+		if !exists {
+			t.Errorf("expected %q to be present", label)
+			continue
+		}
+		if *got != want {
+			t.Errorf("GetBytes(%q) = %d, want %d", label, *got, want)
+		}
+	}
+
+	if _, exists := GetBytes(trie, []byte("missing.example.com")); exists {
+		t.Error("expected GetBytes to report missing key as absent")
+	}
+}
+
+func TestByteTrieDelete(t *testing.T) {
+	trie := NewByteTrie[int]()
+
+	InsertBytes(trie, []byte{0xDE, 0xAD, 0xBE, 0xEF}, 1)
+	InsertBytes(trie, []byte{0xDE, 0xAD, 0xC0, 0xDE}, 2)
+
+	if !DeleteBytes(trie, []byte{0xDE, 0xAD, 0xBE, 0xEF}) {
+		t.Fatal("expected DeleteBytes to report the key was deleted")
+	}
+
+	if _, exists := GetBytes(trie, []byte{0xDE, 0xAD, 0xBE, 0xEF}); exists {
+		t.Error("expected deleted key to be gone")
+	}
+
+	if got, exists := GetBytes(trie, []byte{0xDE, 0xAD, 0xC0, 0xDE}); !exists || *got != 2 {
+		t.Error("expected sibling key to survive deletion of its sibling")
+	}
+
+	if DeleteBytes(trie, []byte{0xDE, 0xAD, 0xBE, 0xEF}) {
+		t.Error("expected DeleteBytes to report false for an already-deleted key")
+	}
+
+	if DeleteBytes(trie, nil) {
+		t.Error("expected DeleteBytes to report false for an empty key")
+	}
+}
+
+func TestByteTriePrefixSearch(t *testing.T) {
+	trie := NewByteTrie[int]()
+
+	labels := []string{"api.example.com", "api.example.org", "www.example.com"}
+	for i, label := range labels {
+		InsertBytes(trie, []byte(label), i)
+	}
+
+	matches := trie.PrefixSearch([]byte("api.example."))
+	got := sortByteKeys(matches)
+	want := []string{"api.example.com", "api.example.org"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PrefixSearch(%q) = %v, want %v", "api.example.", got, want)
+	}
+
+	if matches := trie.PrefixSearch([]byte("ftp.")); len(matches) != 0 {
+		t.Errorf("expected no matches for unmatched prefix, got %v", matches)
+	}
+}
@@ -0,0 +1,161 @@
+package trie
+
+import "cmp"
+
+// Hit describes one occurrence of a stored key found while scanning text
+// with a Matcher.
+type Hit[TKey any, TValue any] struct {
+	Key   []TKey
+	Value *TValue
+	Start int
+	End   int
+}
+
+// automatonNode is one state of the compiled Aho-Corasick automaton. Unlike
+// TrieNode, its edges are single-symbol: the failure-link construction
+// needs to step one symbol at a time, so BuildMatcher expands each
+// TrieNode's (possibly multi-symbol) prefix chunk into a chain of these
+// before computing links.
+type automatonNode[TKey cmp.Ordered, TValue any] struct {
+	children map[TKey]*automatonNode[TKey, TValue]
+	fail     *automatonNode[TKey, TValue]
+
+	// output holds every key that ends at this state, whether matched
+	// directly or reached via a chain of failure links, so FindAll can
+	// report every (possibly overlapping) match in a single pass.
+	output []matchRecord[TKey, TValue]
+}
+
+type matchRecord[TKey any, TValue any] struct {
+	key   []TKey
+	value *TValue
+}
+
+func newAutomatonNode[TKey cmp.Ordered, TValue any]() *automatonNode[TKey, TValue] {
+	return &automatonNode[TKey, TValue]{children: make(map[TKey]*automatonNode[TKey, TValue])}
+}
+
+// Matcher is an Aho-Corasick automaton compiled from a Trie's keys, letting
+// every stored key be located in a single pass over arbitrary text.
+type Matcher[TKey cmp.Ordered, TValue any] struct {
+	root *automatonNode[TKey, TValue]
+}
+
+// BuildMatcher compiles the trie's keys into an Aho-Corasick automaton:
+// first the goto function (a one-symbol-per-edge trie expanded from this
+// Trie's radix-compressed nodes), then failure links via a breadth-first
+// walk, then output sets unioned along those failure links.
+func (self *Trie[TKey, TValue]) BuildMatcher() *Matcher[TKey, TValue] {
+	root := newAutomatonNode[TKey, TValue]()
+	buildGoto(root, nil, self.root)
+	computeFailureLinks(root)
+	return &Matcher[TKey, TValue]{root: root}
+}
+
+func buildGoto[TKey cmp.Ordered, TValue any](parent *automatonNode[TKey, TValue], keySoFar []TKey, node *TrieNode[TKey, TValue]) {
+	current := parent
+	for _, sym := range node.prefix {
+		next, exists := current.children[sym]
+		if !exists {
+			next = newAutomatonNode[TKey, TValue]()
+			current.children[sym] = next
+		}
+		current = next
+		keySoFar = append(append([]TKey(nil), keySoFar...), sym)
+	}
+
+	if node.isEndOfWord() {
+		current.output = append(current.output, matchRecord[TKey, TValue]{key: keySoFar, value: node.value})
+	}
+
+	node.children.forEach(func(_ TKey, child *TrieNode[TKey, TValue]) {
+		buildGoto(current, keySoFar, child)
+	})
+}
+
+func computeFailureLinks[TKey cmp.Ordered, TValue any](root *automatonNode[TKey, TValue]) {
+	root.fail = root
+
+	queue := make([]*automatonNode[TKey, TValue], 0, len(root.children))
+	for _, child := range root.children {
+		child.fail = root
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for sym, child := range current.children {
+			queue = append(queue, child)
+
+			state := current.fail
+			for state != root {
+				if _, ok := state.children[sym]; ok {
+					break
+				}
+				state = state.fail
+			}
+
+			if next, ok := state.children[sym]; ok && next != child {
+				child.fail = next
+			} else {
+				child.fail = root
+			}
+
+			child.output = append(child.output, child.fail.output...)
+		}
+	}
+}
+
+// FindAll scans text once and returns every occurrence of every stored key,
+// including overlapping matches, in the order their end positions appear in
+// text. Start/End are symbol offsets into text, with End exclusive.
+func (self *Matcher[TKey, TValue]) FindAll(text []TKey) []Hit[TKey, TValue] {
+	hits := []Hit[TKey, TValue]{}
+
+	current := self.root
+	for index, sym := range text {
+		for current != self.root {
+			if _, ok := current.children[sym]; ok {
+				break
+			}
+			current = current.fail
+		}
+		if next, ok := current.children[sym]; ok {
+			current = next
+		}
+
+		for _, record := range current.output {
+			hits = append(hits, Hit[TKey, TValue]{
+				Key:   record.key,
+				Value: record.value,
+				Start: index - len(record.key) + 1,
+				End:   index + 1,
+			})
+		}
+	}
+
+	return hits
+}
+
+// HitString is Hit with the key rendered back to a string, as returned by
+// FindAllString.
+type HitString[TValue any] struct {
+	Key   string
+	Value *TValue
+	Start int
+	End   int
+}
+
+// FindAllString is FindAll for a Matcher built from a rune-keyed Trie. Go
+// can't specialize a method for one instantiation of a generic type, so
+// this is a free function rather than a method on Matcher.
+func FindAllString[TValue any](m *Matcher[rune, TValue], text string) []HitString[TValue] {
+	hits := m.FindAll([]rune(text))
+	results := make([]HitString[TValue], len(hits))
+	for i, h := range hits {
+		results[i] = HitString[TValue]{Key: string(h.Key), Value: h.Value, Start: h.Start, End: h.End}
+	}
+	return results
+}
@@ -0,0 +1,94 @@
+package trie
+
+import "testing"
+
+func insertMatcherFixture(trie *Trie[rune, int]) {
+	words := []string{"he", "she", "his", "hers"}
+	for i, word := range words {
+		InsertString(trie, word, i)
+	}
+}
+
+func TestMatcherFindAllOverlappingMatches(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertMatcherFixture(trie)
+	matcher := trie.BuildMatcher()
+
+	hits := FindAllString(matcher, "ushers")
+
+	found := map[string]bool{}
+	for _, hit := range hits {
+		found[hit.Key] = true
+		if hit.End-hit.Start != len([]rune(hit.Key)) {
+			t.Errorf("hit %+v has a span that doesn't match its key length", hit)
+		}
+		if got := []rune("ushers")[hit.Start:hit.End]; string(got) != hit.Key {
+			t.Errorf("hit %+v: text[%d:%d] = %q, want %q", hit, hit.Start, hit.End, string(got), hit.Key)
+		}
+	}
+
+	for _, key := range []string{"she", "he", "hers"} {
+		if !found[key] {
+			t.Errorf("expected FindAll(%q) to include %q, got %v", "ushers", key, hits)
+		}
+	}
+	if found["his"] {
+		t.Errorf("did not expect FindAll(%q) to match %q", "ushers", "his")
+	}
+}
+
+func TestMatcherFindAllNoMatches(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertMatcherFixture(trie)
+	matcher := trie.BuildMatcher()
+
+	hits := FindAllString(matcher, "xyz")
+	if len(hits) != 0 {
+		t.Errorf("expected no hits, got %v", hits)
+	}
+}
+
+func TestMatcherFindAllEmptyTrie(t *testing.T) {
+	trie := NewStringTrie[int]()
+	matcher := trie.BuildMatcher()
+
+	hits := FindAllString(matcher, "anything at all")
+	if len(hits) != 0 {
+		t.Errorf("expected no hits from an empty trie's matcher, got %v", hits)
+	}
+}
+
+func TestMatcherFindAllRepeatedPattern(t *testing.T) {
+	trie := NewStringTrie[int]()
+	word := "aa"
+	InsertString(trie, word, 1)
+	matcher := trie.BuildMatcher()
+
+	hits := FindAllString(matcher, "aaaa")
+
+	if len(hits) != 3 {
+		t.Fatalf("expected 3 overlapping matches of 'aa' in 'aaaa', got %d: %v", len(hits), hits)
+	}
+	for i, hit := range hits {
+		if hit.Start != i || hit.End != i+2 {
+			t.Errorf("hit %d: expected span [%d,%d), got [%d,%d)", i, i, i+2, hit.Start, hit.End)
+		}
+	}
+}
+
+func TestMatcherFindAllValuesMatchInsertedValues(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertMatcherFixture(trie)
+	matcher := trie.BuildMatcher()
+
+	hits := FindAllString(matcher, "she")
+	for _, hit := range hits {
+		expected, ok := GetString(trie, hit.Key)
+		if !ok {
+			t.Fatalf("matcher returned key %q not present in trie", hit.Key)
+		}
+		if hit.Value == nil || *hit.Value != *expected {
+			t.Errorf("hit %+v has value %v, want %v", hit, hit.Value, *expected)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package trie
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/gob"
+	"errors"
+)
+
+// binaryMagic identifies a MarshalBinary payload as belonging to this
+// package, so UnmarshalBinary can reject garbage input early.
+const binaryMagic uint32 = 0x54524945 // "TRIE"
+
+// binaryVersion is bumped whenever the on-disk format changes incompatibly.
+const binaryVersion uint32 = 1
+
+var (
+	ErrInvalidMagic       = errors.New("trie: invalid magic number")
+	ErrUnsupportedVersion = errors.New("trie: unsupported binary version")
+)
+
+// serializedNode is a gob-friendly mirror of TrieNode, written/read in
+// pre-order: a node's own prefix and optional value, followed by its
+// children.
+type serializedNode[TKey cmp.Ordered, TValue any] struct {
+	Prefix   []TKey
+	HasValue bool
+	Value    TValue
+	Children []serializedNode[TKey, TValue]
+}
+
+// serializedTrie is the full MarshalBinary payload: a header (magic number,
+// format version, the trie's node-compaction setting) followed by the
+// pre-order node stream.
+type serializedTrie[TKey cmp.Ordered, TValue any] struct {
+	Magic            uint32
+	Version          uint32
+	MaxPrefixPerNode int
+	Root             serializedNode[TKey, TValue]
+}
+
+// MarshalBinary encodes the trie - using encoding/gob for TKey and TValue -
+// as a header followed by a pre-order stream of nodes, so a populated trie
+// (e.g. built via AddKeyList) can be persisted and reloaded without
+// re-inserting every key.
+func (self *Trie[TKey, TValue]) MarshalBinary() ([]byte, error) {
+	payload := serializedTrie[TKey, TValue]{
+		Magic:            binaryMagic,
+		Version:          binaryVersion,
+		MaxPrefixPerNode: self.maxPrefixPerNode,
+		Root:             toSerializedNode(self.root),
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(payload); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a payload produced by MarshalBinary, replacing
+// the receiver's contents.
+func (self *Trie[TKey, TValue]) UnmarshalBinary(data []byte) error {
+	var payload serializedTrie[TKey, TValue]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&payload); err != nil {
+		return err
+	}
+
+	if payload.Magic != binaryMagic {
+		return ErrInvalidMagic
+	}
+	if payload.Version != binaryVersion {
+		return ErrUnsupportedVersion
+	}
+
+	self.maxPrefixPerNode = payload.MaxPrefixPerNode
+	self.root = fromSerializedNode(payload.Root)
+	return nil
+}
+
+func toSerializedNode[TKey cmp.Ordered, TValue any](node *TrieNode[TKey, TValue]) serializedNode[TKey, TValue] {
+	serialized := serializedNode[TKey, TValue]{Prefix: node.prefix}
+	if node.value != nil {
+		serialized.HasValue = true
+		serialized.Value = *node.value
+	}
+
+	node.children.forEach(func(_ TKey, child *TrieNode[TKey, TValue]) {
+		serialized.Children = append(serialized.Children, toSerializedNode(child))
+	})
+	return serialized
+}
+
+func fromSerializedNode[TKey cmp.Ordered, TValue any](serialized serializedNode[TKey, TValue]) *TrieNode[TKey, TValue] {
+	node := newTrieNodeWithPrefix[TKey, TValue](serialized.Prefix)
+	if serialized.HasValue {
+		value := serialized.Value
+		node.value = &value
+	}
+
+	for _, childData := range serialized.Children {
+		child := fromSerializedNode(childData)
+		node.children.set(child.prefix[0], child)
+	}
+	return node
+}
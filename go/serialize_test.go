@@ -0,0 +1,103 @@
+package trie
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+)
+
+func insertSerializeFixture(trie *Trie[rune, int]) {
+	words := []string{"cat", "cats", "car", "card", "care", "careful", "dog", "doggy"}
+	for i, word := range words {
+		InsertString(trie, word, i)
+	}
+}
+
+func TestMarshalUnmarshalRoundTrip(t *testing.T) {
+	original := NewStringTrie[int]()
+	insertSerializeFixture(original)
+
+	data, err := original.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary returned error: %v", err)
+	}
+
+	restored := NewStringTrie[int]()
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary returned error: %v", err)
+	}
+
+	words := []string{"cat", "cats", "car", "card", "care", "careful", "dog", "doggy"}
+	for i, word := range words {
+		value, ok := GetString(restored, word)
+		if !ok {
+			t.Errorf("expected %q to round-trip, but it was missing", word)
+			continue
+		}
+		if *value != i {
+			t.Errorf("expected %q to round-trip with value %d, got %d", word, i, *value)
+		}
+	}
+
+	missing := "missing"
+	if _, ok := GetString(restored, missing); ok {
+		t.Error("expected restored trie to not contain keys absent from the original")
+	}
+}
+
+func TestMarshalEmptyTrie(t *testing.T) {
+	original := NewStringTrie[int]()
+
+	data, err := original.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary returned error: %v", err)
+	}
+
+	restored := NewStringTrie[int]()
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary returned error: %v", err)
+	}
+
+	missing := "anything"
+	if _, ok := GetString(restored, missing); ok {
+		t.Error("expected an empty restored trie to contain nothing")
+	}
+}
+
+func TestUnmarshalRejectsInvalidMagic(t *testing.T) {
+	payload := serializedTrie[rune, int]{
+		Magic:   binaryMagic + 1,
+		Version: binaryVersion,
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(payload); err != nil {
+		t.Fatalf("failed to encode fixture payload: %v", err)
+	}
+
+	trie := NewStringTrie[int]()
+	err := trie.UnmarshalBinary(buf.Bytes())
+	if !errors.Is(err, ErrInvalidMagic) {
+		t.Errorf("expected ErrInvalidMagic, got %v", err)
+	}
+}
+
+func TestMarshalPreservesMaxPrefixPerNode(t *testing.T) {
+	original := NewTrieWithMaxPrefix[rune, int](3)
+	insertSerializeFixture(original)
+
+	data, err := original.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary returned error: %v", err)
+	}
+
+	restored := NewStringTrie[int]()
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary returned error: %v", err)
+	}
+
+	if restored.maxPrefixPerNode != 3 {
+		t.Errorf("expected maxPrefixPerNode to round-trip as 3, got %d", restored.maxPrefixPerNode)
+	}
+}
@@ -0,0 +1,72 @@
+package trie
+
+// SyncTrie is a string-keyed, concurrency-safe trie: a thin facade over
+// ConcurrentTrie[rune, TValue] so callers working with text keys don't have
+// to convert to and from []rune at every call site. Insert, Delete, and
+// AddWordList take ConcurrentTrie's write lock; Get, PrefixSearch, and
+// AutoComplete take its read lock.
+//
+// Snapshot returns an immutable point-in-time view backed by the same
+// copy-on-write node sharing ConcurrentTrie.Snapshot provides, so a
+// long-running reader - an autocomplete server walking the index on every
+// request, say - can keep iterating a consistent view while a background
+// goroutine inserts new keys, without blocking on or being blocked by that
+// writer the way a plain sync.RWMutex around a single shared trie would.
+type SyncTrie[TValue any] struct {
+	trie *ConcurrentTrie[rune, TValue]
+}
+
+// NewSyncTrie creates an empty, concurrency-safe Trie keyed by string.
+func NewSyncTrie[TValue any]() *SyncTrie[TValue] {
+	return &SyncTrie[TValue]{trie: NewConcurrentTrie[rune, TValue]()}
+}
+
+// Insert adds a key-value pair under the write lock.
+func (self *SyncTrie[TValue]) Insert(key string, value TValue) {
+	self.trie.Insert([]rune(key), value)
+}
+
+// Delete removes a key under the write lock.
+func (self *SyncTrie[TValue]) Delete(key string) bool {
+	return self.trie.Delete([]rune(key))
+}
+
+// Get looks up a key under the read lock.
+func (self *SyncTrie[TValue]) Get(key string) (*TValue, bool) {
+	return self.trie.Get([]rune(key))
+}
+
+// PrefixSearch returns every key with the given prefix under the read lock.
+func (self *SyncTrie[TValue]) PrefixSearch(prefix string) []string {
+	return runeKeysToStrings(self.trie.PrefixSearch([]rune(prefix)))
+}
+
+// AutoComplete returns every key with the given prefix under the read lock.
+func (self *SyncTrie[TValue]) AutoComplete(prefix string) []string {
+	return runeKeysToStrings(self.trie.AutoComplete([]rune(prefix)))
+}
+
+// AddWordList inserts every word in words under a single write lock, value
+// generated per word by valueGenerator.
+func (self *SyncTrie[TValue]) AddWordList(words []string, valueGenerator func(string) TValue) {
+	keys := make([][]rune, len(words))
+	for i, word := range words {
+		keys[i] = []rune(word)
+	}
+	self.trie.AddKeyList(keys, func(key []rune) TValue { return valueGenerator(string(key)) })
+}
+
+// Snapshot returns an immutable point-in-time view of the trie. The result
+// is a plain Trie, so it reads with the usual String-suffixed free functions
+// (GetString, PrefixSearchString, AutoCompleteString, ...).
+func (self *SyncTrie[TValue]) Snapshot() *Trie[rune, TValue] {
+	return self.trie.Snapshot()
+}
+
+func runeKeysToStrings(keys [][]rune) []string {
+	results := make([]string, len(keys))
+	for i, key := range keys {
+		results[i] = string(key)
+	}
+	return results
+}
@@ -0,0 +1,104 @@
+package trie
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSyncTrieInsertGetDelete(t *testing.T) {
+	trie := NewSyncTrie[int]()
+
+	trie.Insert("apple", 42)
+
+	value, exists := trie.Get("apple")
+	if !exists || value == nil || *value != 42 {
+		t.Errorf("Expected apple=42, got value=%v exists=%v", value, exists)
+	}
+
+	if !trie.Delete("apple") {
+		t.Error("Delete should return true for existing key")
+	}
+	if _, exists := trie.Get("apple"); exists {
+		t.Error("Deleted key should not exist")
+	}
+}
+
+func TestSyncTriePrefixSearchAndAutoComplete(t *testing.T) {
+	trie := NewSyncTrie[int]()
+
+	trie.Insert("app", 1)
+	trie.Insert("apple", 2)
+	trie.Insert("banana", 3)
+
+	matches := trie.PrefixSearch("app")
+	if len(matches) != 2 {
+		t.Errorf("Expected 2 matches for prefix 'app', got %d: %v", len(matches), matches)
+	}
+
+	completions := trie.AutoComplete("app")
+	if len(completions) != len(matches) {
+		t.Errorf("AutoComplete should agree with PrefixSearch, got %v vs %v", completions, matches)
+	}
+}
+
+func TestSyncTrieAddWordList(t *testing.T) {
+	trie := NewSyncTrie[int]()
+
+	words := []string{"cat", "car", "cats"}
+	trie.AddWordList(words, func(word string) int { return len(word) })
+
+	for _, word := range words {
+		value, exists := trie.Get(word)
+		if !exists || value == nil || *value != len(word) {
+			t.Errorf("Expected %s=%d, got value=%v exists=%v", word, len(word), value, exists)
+		}
+	}
+}
+
+func TestSyncTrieSnapshotIsolation(t *testing.T) {
+	trie := NewSyncTrie[int]()
+
+	trie.Insert("apple", 1)
+	snapshot := trie.Snapshot()
+
+	trie.Insert("apple", 2)
+	trie.Insert("banana", 3)
+	trie.Delete("apple")
+
+	value, exists := GetString(snapshot, "apple")
+	if !exists || value == nil || *value != 1 {
+		t.Errorf("Snapshot should still see apple=1, got value=%v exists=%v", value, exists)
+	}
+	if _, exists := GetString(snapshot, "banana"); exists {
+		t.Error("Snapshot should not see keys inserted after it was taken")
+	}
+
+	if _, exists := trie.Get("apple"); exists {
+		t.Error("Live trie should reflect the later delete of apple")
+	}
+}
+
+func TestSyncTrieParallelReadWrite(t *testing.T) {
+	trie := NewSyncTrie[int]()
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			trie.Insert(fmt.Sprintf("word_%d", i), i)
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		trie.AutoComplete("word_")
+	}
+	<-done
+
+	for i := 0; i < 100; i++ {
+		word := fmt.Sprintf("word_%d", i)
+		value, exists := trie.Get(word)
+		if !exists || value == nil || *value != i {
+			t.Errorf("Expected %s=%d after concurrent inserts, got value=%v exists=%v", word, i, value, exists)
+		}
+	}
+}
@@ -1,38 +1,132 @@
 package trie
 
 import (
+	"cmp"
 	"errors"
+	"fmt"
 )
 
 type TrieError int
 
 var TrieErrorChildDoesNotExist = errors.New("No child available")
 
-type Trie[TValue any] struct {
-	// The root node of the Trie. It does not hold any character itself.
-	root *TrieNode[TValue]
+// Sentinel errors for GetByPrefix.
+var (
+	ErrEmptyPrefix    = errors.New("trie: prefix is empty")
+	ErrPrefixNotFound = errors.New("trie: no key matches prefix")
+)
+
+// ErrAmbiguousPrefix is returned by GetByPrefix when more than one key
+// shares the given prefix. Matches is the branching factor at the point
+// the ambiguity was detected - a lower bound on how many keys share the
+// prefix, not an exact count, since GetByPrefix stops descending as soon
+// as it knows the answer isn't unique.
+type ErrAmbiguousPrefix[TKey any] struct {
+	Prefix  []TKey
+	Matches int
+}
+
+func (e *ErrAmbiguousPrefix[TKey]) Error() string {
+	return fmt.Sprintf("trie: prefix %v is ambiguous, matches: %v", e.Prefix, e.Matches)
+}
+
+// DefaultMaxPrefixPerNode bounds how many symbols a single node's prefix
+// chunk may hold before Insert must chain an additional node. Smaller
+// values keep individual nodes cheap to split/merge; larger values reduce
+// the number of nodes (and therefore pointer chases) for long, low-branching
+// keys.
+const DefaultMaxPrefixPerNode = 10
+
+// Trie is generic over both the symbol alphabet a key is made of (TKey) and
+// the value it stores (TValue). Most callers want the rune or byte
+// alphabets, for which NewStringTrie and NewByteTrie (in keytrie.go) are
+// more convenient than instantiating Trie directly.
+type Trie[TKey cmp.Ordered, TValue any] struct {
+	// The root node of the Trie. It does not hold any symbol itself.
+	root *TrieNode[TKey, TValue]
+
+	// maxPrefixPerNode caps the length of any single node's prefix chunk.
+	maxPrefixPerNode int
+}
+
+// NewTrie creates an empty Trie whose nodes chunk prefixes up to
+// DefaultMaxPrefixPerNode symbols.
+func NewTrie[TKey cmp.Ordered, TValue any]() *Trie[TKey, TValue] {
+	return NewTrieWithMaxPrefix[TKey, TValue](DefaultMaxPrefixPerNode)
+}
+
+// NewTrieWithMaxPrefix creates an empty Trie whose nodes chunk prefixes up
+// to maxPrefixPerNode symbols. Most callers should use NewTrie; this exists
+// for callers who want to tune the space/depth tradeoff, e.g. a very small
+// value to keep splits cheap on a heavily-mutated trie.
+func NewTrieWithMaxPrefix[TKey cmp.Ordered, TValue any](maxPrefixPerNode int) *Trie[TKey, TValue] {
+	return &Trie[TKey, TValue]{
+		root:             NewTrieNode[TKey, TValue](),
+		maxPrefixPerNode: maxPrefixPerNode,
+	}
 }
 
 // Insert adds a key-value pair into the Trie.
-// The value marks the end of a string and can be retrieved later.
+// The value marks the end of a key and can be retrieved later.
 // If the key already exists, its value is updated.
 //
 // Example:
 //
-//	trie := NewTrie()
-//	trie.Insert("apple", 1)
-//	value, exists := trie.Get("apple")
+//	trie := NewStringTrie[int]()
+//	InsertString(trie, "apple", 1)
+//	value, exists := GetString(trie, "apple")
 //	// value == 1, exists == true
-func (self *Trie[TValue]) Insert(key *string, value TValue) {
-	currentNode := self.root
-	for _, char := range *key {
-		if !currentNode.containsChild(char) {
-			currentNode.addChild(char)
+func (self *Trie[TKey, TValue]) Insert(key []TKey, value TValue) {
+	self.insertSymbols(self.root, key, value)
+}
+
+// insertSymbols walks/splits/extends the tree so that remaining is consumed
+// starting at node, then records value at the resulting terminal node.
+func (self *Trie[TKey, TValue]) insertSymbols(node *TrieNode[TKey, TValue], remaining []TKey, value TValue) {
+	if len(remaining) == 0 {
+		node.setValue(value)
+		return
+	}
+
+	child, err := node.getChildMut(remaining[0])
+	if err != nil {
+		chunk := remaining
+		if len(chunk) > self.maxPrefixPerNode {
+			chunk = chunk[:self.maxPrefixPerNode]
 		}
-		child, _ := currentNode.getChildMut(char)
-		currentNode = child
+		newChild := node.addChild(append([]TKey(nil), chunk...))
+		self.insertSymbols(newChild, remaining[len(chunk):], value)
+		return
+	}
+
+	common := commonPrefixLen(child.prefix, remaining)
+	if common == len(child.prefix) {
+		// The whole edge matched; continue into the child with whatever
+		// of the key remains.
+		self.insertSymbols(child, remaining[common:], value)
+		return
 	}
-	currentNode.setValue(value)
+
+	// The key diverges partway through the child's prefix: split the child
+	// into a shared-prefix node and a suffix child, then continue inserting
+	// under the split point.
+	splitNode := self.splitChild(node, child, common)
+	self.insertSymbols(splitNode, remaining[common:], value)
+}
+
+// splitChild divides child's prefix at the given length, inserting a new
+// node that owns the shared prefix in child's place and demoting the
+// original child (now holding only the divergent suffix) beneath it.
+func (self *Trie[TKey, TValue]) splitChild(parent *TrieNode[TKey, TValue], child *TrieNode[TKey, TValue], commonLen int) *TrieNode[TKey, TValue] {
+	sharedPrefix := append([]TKey(nil), child.prefix[:commonLen]...)
+	suffix := append([]TKey(nil), child.prefix[commonLen:]...)
+
+	splitNode := newTrieNodeWithPrefix[TKey, TValue](sharedPrefix)
+	child.prefix = suffix
+	splitNode.children.set(suffix[0], child)
+	parent.children.set(sharedPrefix[0], splitNode)
+
+	return splitNode
 }
 
 // Get searches for a key and returns its value if it exists.
@@ -40,20 +134,22 @@ func (self *Trie[TValue]) Insert(key *string, value TValue) {
 //
 // Example:
 //
-//	trie := NewTrie()
-//	trie.Insert("apple", 1)
-//	value, exists := trie.Get("apple") // value == 1, exists == true
-func (self *Trie[TValue]) Get(key *string) (*TValue, bool) {
-	currentNode := self.root
-	for _, char := range *key {
-		if child, err := currentNode.getChildMut(char); err == nil {
-			currentNode = child
-		} else {
+//	trie := NewStringTrie[int]()
+//	InsertString(trie, "apple", 1)
+//	value, exists := GetString(trie, "apple") // value == 1, exists == true
+func (self *Trie[TKey, TValue]) Get(key []TKey) (*TValue, bool) {
+	node := self.root
+	remaining := key
+	for len(remaining) > 0 {
+		child, err := node.getChildMut(remaining[0])
+		if err != nil || len(remaining) < len(child.prefix) || !symbolsEqual(child.prefix, remaining[:len(child.prefix)]) {
 			return nil, false
 		}
+		remaining = remaining[len(child.prefix):]
+		node = child
 	}
 
-	return currentNode.getValue()
+	return node.getValue()
 }
 
 // Delete removes a key and its associated value from the Trie.
@@ -61,21 +157,21 @@ func (self *Trie[TValue]) Get(key *string) (*TValue, bool) {
 //
 // Example:
 //
-//	trie := NewTrie()
-//	trie.Insert("apple", 1)
-//	deleted := trie.Delete("apple") // deleted == true
-//	value, exists := trie.Get("apple") // exists == false
-func (self *Trie[TValue]) Delete(key *string) bool {
-	if key == nil || *key == "" {
+//	trie := NewStringTrie[int]()
+//	InsertString(trie, "apple", 1)
+//	deleted := DeleteString(trie, "apple") // deleted == true
+//	_, exists := GetString(trie, "apple") // exists == false
+func (self *Trie[TKey, TValue]) Delete(key []TKey) bool {
+	if len(key) == 0 {
 		// Empty key is not allowed
 		return false
 	}
-	deleted, _ := self.deleteRecursive(self.root, *key)
+	deleted, _ := self.deleteRecursive(self.root, key)
 	return deleted
 }
 
-func (self *Trie[TValue]) deleteRecursive(currentNode *TrieNode[TValue], key string) (bool, bool) {
-	if key == "" {
+func (self *Trie[TKey, TValue]) deleteRecursive(currentNode *TrieNode[TKey, TValue], remaining []TKey) (bool, bool) {
+	if len(remaining) == 0 {
 
 		// We have reached the node corresponding to the key.
 		if currentNode.isEndOfWord() {
@@ -88,79 +184,197 @@ func (self *Trie[TValue]) deleteRecursive(currentNode *TrieNode[TValue], key str
 		return false, false
 	}
 
-	runes := []rune(key)
-	c := runes[0]
-	remaining := string(runes[1:])
-	if child, err := currentNode.getChildMut(c); err == nil {
-		deleted, shouldDeleteChild := self.deleteRecursive(child, remaining)
-		if shouldDeleteChild {
-			currentNode.removeChild(c)
-		}
-
-		shouldPruneThisNode := deleted && !currentNode.isEndOfWord() && !currentNode.hasChildren()
-		return deleted, shouldPruneThisNode
-	} else {
+	child, err := currentNode.getChildMut(remaining[0])
+	if err != nil || len(remaining) < len(child.prefix) || !symbolsEqual(child.prefix, remaining[:len(child.prefix)]) {
 		// The path for the key does not exist.
 		return false, false
 	}
+
+	deleted, shouldDeleteChild := self.deleteRecursive(child, remaining[len(child.prefix):])
+	if !deleted {
+		return false, false
+	}
+
+	if shouldDeleteChild {
+		currentNode.removeChild(remaining[0])
+	} else {
+		// child survives; collapse it into its own sole remaining child (if
+		// any) so the tree doesn't accumulate single-child chains.
+		self.mergeIfPossible(child)
+	}
+
+	shouldPruneThisNode := !currentNode.isEndOfWord() && !currentNode.hasChildren()
+	return deleted, shouldPruneThisNode
 }
 
-func (self *Trie[TValue]) PrefixSearch(prefix *string) bool {
-	currentNode := self.root // Use local variable!
-	for _, char := range *prefix {
-		if child, err := currentNode.getChildMut(char); err == nil {
-			currentNode = child // Modify local variable, not self.root
-		} else {
-			return false
-		}
+// mergeIfPossible collapses node into its sole child when node carries no
+// value of its own and the combined prefix fits within maxPrefixPerNode.
+// The merged node takes over the child's value (if any) and children,
+// keeping the compressed tree from accumulating single-child chains after
+// deletions.
+func (self *Trie[TKey, TValue]) mergeIfPossible(node *TrieNode[TKey, TValue]) {
+	if node.isEndOfWord() || node.children.len() != 1 {
+		return
+	}
+
+	var onlyChild *TrieNode[TKey, TValue]
+	node.children.forEach(func(_ TKey, child *TrieNode[TKey, TValue]) {
+		onlyChild = child
+	})
+
+	if len(node.prefix)+len(onlyChild.prefix) > self.maxPrefixPerNode {
+		return
 	}
-	return true // If we got here, prefix exists
+
+	node.prefix = append(node.prefix, onlyChild.prefix...)
+	node.children = onlyChild.children
+	node.value = onlyChild.value
+}
+
+// PrefixSearch performs a prefix search on the trie and returns all keys
+// that start with the given prefix. If no keys match the prefix, an empty
+// list is returned.
+func (self *Trie[TKey, TValue]) PrefixSearch(prefix []TKey) [][]TKey {
+	results := [][]TKey{}
+	self.WalkPrefix(prefix, func(key []TKey, _ *TValue) error {
+		results = append(results, key)
+		return nil
+	})
+	return results
 }
 
-// Preforms a prefix serach on the trie and returns all words that start with the given prefix.
-// If no words match the prefix, an empty list is returned.
+// Preforms a prefix serach on the trie and returns all keys that start with the given prefix.
+// If no keys match the prefix, an empty list is returned.
 //
 // Example:
 //
-//	trie := NewTrie()
-//	trie.Insert("apple", 1)
-//	trie.Insert("app", 2)
-//	words := trie.AutoComplete("app") // words == ["app", "apple"]
-func (self *Trie[TValue]) AutoComplete(prefix *string) []string {
-	results := []string{}
-	currentNode := self.root
-	for _, char := range *prefix {
-		if child, err := currentNode.getChildMut(char); err == nil {
-			currentNode = child
-		} else {
-			// Prefix not found, return empty list
-			return results
+//	trie := NewStringTrie[int]()
+//	InsertString(trie, "apple", 1)
+//	InsertString(trie, "app", 2)
+//	words := AutoCompleteString(trie, "app") // words == ["app", "apple"]
+func (self *Trie[TKey, TValue]) AutoComplete(prefix []TKey) [][]TKey {
+	return self.PrefixSearch(prefix)
+}
+
+// findPrefixNode walks the trie along prefix and returns the node whose
+// subtree holds exactly the keys starting with prefix, along with the full
+// path (root to node inclusive) that the returned node represents - which
+// may be longer than prefix itself if prefix ends partway through a node's
+// prefix chunk.
+func (self *Trie[TKey, TValue]) findPrefixNode(prefix []TKey) (*TrieNode[TKey, TValue], []TKey, bool) {
+	node := self.root
+	accumulated := []TKey{}
+	remaining := prefix
+
+	for len(remaining) > 0 {
+		child, err := node.getChildMut(remaining[0])
+		if err != nil {
+			return nil, nil, false
 		}
+
+		matchLen := commonPrefixLen(child.prefix, remaining)
+		if matchLen != len(child.prefix) && matchLen != len(remaining) {
+			return nil, nil, false
+		}
+
+		accumulated = append(accumulated, child.prefix...)
+		node = child
+		if matchLen == len(remaining) {
+			return node, accumulated, true
+		}
+		remaining = remaining[matchLen:]
 	}
 
-	self.collectWordsRecursive(currentNode, *prefix, &results)
-	return results
+	return node, accumulated, true
 }
 
-// Helper function to recursively collect words from the trie.
-// Adds prefix to results if prefix is  a complete word, then continues to explore children to find
-// extensions of the prefix.
-func (self *Trie[TValue]) collectWordsRecursive(node *TrieNode[TValue], currPrefix string, results *[]string) {
-	// If the current node marks the end of a word, add the current prefix to results.
-	if node.isEndOfWord() {
-		*results = append(*results, currPrefix)
+// GetByPrefix returns the single key (and its value) that has the given
+// prefix, for the classic "truncated container ID" use case (resolving a
+// short ID like "a1b2" to the one full ID it identifies). It returns
+// ErrEmptyPrefix for an empty prefix, ErrPrefixNotFound when no key
+// matches, and an *ErrAmbiguousPrefix when more than one key shares the
+// prefix.
+//
+// Unlike a full autocomplete scan, this only follows the unbranching chain
+// below the prefix node until it hits either a terminal or a branch point,
+// so it costs O(len(prefix) + len(unique suffix)) rather than O(subtree
+// size).
+func (self *Trie[TKey, TValue]) GetByPrefix(prefix []TKey) ([]TKey, *TValue, error) {
+	if len(prefix) == 0 {
+		return nil, nil, ErrEmptyPrefix
 	}
-	// Continue with all children to find extensions.
-	for char, child := range node.children {
-		newPrefix := currPrefix + string(char)
-		self.collectWordsRecursive(child, newPrefix, results)
+
+	node, accumulated, ok := self.findPrefixNode(prefix)
+	if !ok {
+		return nil, nil, ErrPrefixNotFound
 	}
+
+	for {
+		if node.isEndOfWord() {
+			if node.children.len() == 0 {
+				return accumulated, node.value, nil
+			}
+			return nil, nil, &ErrAmbiguousPrefix[TKey]{Prefix: prefix, Matches: node.children.len() + 1}
+		}
+
+		if node.children.len() != 1 {
+			return nil, nil, &ErrAmbiguousPrefix[TKey]{Prefix: prefix, Matches: node.children.len()}
+		}
+
+		var onlyChild *TrieNode[TKey, TValue]
+		node.children.forEach(func(_ TKey, child *TrieNode[TKey, TValue]) {
+			onlyChild = child
+		})
+		accumulated = append(accumulated, onlyChild.prefix...)
+		node = onlyChild
+	}
+}
+
+// AddKeyList takes a list of keys and a function that generates a value for
+// each key to store at the end of its chain.
+func (self *Trie[TKey, TValue]) AddKeyList(keys [][]TKey, valueGenerator func([]TKey) TValue) {
+	for _, key := range keys {
+		self.Insert(key, valueGenerator(key))
+	}
+}
+
+// nodeCount walks the tree and counts its nodes; used by benchmarks to
+// track how much prefix compression reduces node allocation relative to a
+// classic one-symbol-per-node trie.
+func (self *Trie[TKey, TValue]) nodeCount() int {
+	return countNodes[TKey, TValue](self.root)
+}
+
+func countNodes[TKey cmp.Ordered, TValue any](node *TrieNode[TKey, TValue]) int {
+	count := 1
+	node.children.forEach(func(_ TKey, child *TrieNode[TKey, TValue]) {
+		count += countNodes[TKey, TValue](child)
+	})
+	return count
+}
+
+// commonPrefixLen returns the length of the shared leading symbols of a and b.
+func commonPrefixLen[TKey comparable](a, b []TKey) int {
+	max := len(a)
+	if len(b) < max {
+		max = len(b)
+	}
+	i := 0
+	for i < max && a[i] == b[i] {
+		i++
+	}
+	return i
 }
 
-// Takes a list of words and a function that generates a value for each word to store at the end of
-// the chain.
-func (self *Trie[TValue]) AddWordList(words *[]string, valueGenerator func(string) TValue) {
-	for _, word := range *words {
-		self.Insert(&word, valueGenerator(word))
+// symbolsEqual reports whether a and b hold the same symbols.
+func symbolsEqual[TKey comparable](a, b []TKey) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
 	}
+	return true
 }
@@ -1,72 +1,244 @@
 package trie
 
-// Represents a node within a Trie. It is generic over the value it stores.
-type TrieNode[TValue any] struct {
-	// Note: The `character` for this node is the *key* in the parent's HashMap.
-	// We don't need to store it inside the node itself.
-	children map[rune]*TrieNode[TValue]
+import (
+	"cmp"
+	"slices"
+)
+
+// denseChildThreshold is the largest branching factor a node keeps in its
+// sparse child slice; adding a child beyond it promotes the slice into a
+// map-backed child container. Up to this many children, a sorted,
+// binary-searched slice avoids the allocation and hashing overhead of a map
+// for the common case of narrow, deep paths; above it (e.g. the root of a
+// trie built from English words) a map keeps lookups close to O(1).
+const denseChildThreshold = 8
+
+// childEntry is a single (symbol, node) pair used by the sparse child mode.
+type childEntry[TKey cmp.Ordered, TValue any] struct {
+	sym  TKey
+	node *TrieNode[TKey, TValue]
+}
+
+// childSet holds a node's children, starting out backed by a small slice
+// and promoting itself to a map once the branching factor crosses
+// denseChildThreshold. The zero value is a valid, empty childSet.
+//
+// sparse is kept sorted by sym, so get/set/delete locate an entry with a
+// binary search instead of a linear scan. This is why TKey is constrained to
+// cmp.Ordered rather than just comparable: every symbol alphabet this trie
+// supports (rune, byte, and other integer-like symbol types) is naturally
+// ordered, and giving up that ordering would cost the sparse mode its
+// binary search.
+type childSet[TKey cmp.Ordered, TValue any] struct {
+	sparse []childEntry[TKey, TValue]
+	dense  map[TKey]*TrieNode[TKey, TValue]
+}
+
+func (self *childSet[TKey, TValue]) len() int {
+	if self.dense != nil {
+		return len(self.dense)
+	}
+	return len(self.sparse)
+}
+
+// sparseSearch returns the index of sym in self.sparse, and whether it was
+// found; when not found, the index is where sym should be inserted to
+// keep self.sparse sorted.
+func (self *childSet[TKey, TValue]) sparseSearch(sym TKey) (int, bool) {
+	lo, hi := 0, len(self.sparse)
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if self.sparse[mid].sym < sym {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(self.sparse) && self.sparse[lo].sym == sym
+}
+
+func (self *childSet[TKey, TValue]) get(sym TKey) *TrieNode[TKey, TValue] {
+	if self.dense != nil {
+		return self.dense[sym]
+	}
+	if i, found := self.sparseSearch(sym); found {
+		return self.sparse[i].node
+	}
+	return nil
+}
+
+func (self *childSet[TKey, TValue]) set(sym TKey, node *TrieNode[TKey, TValue]) {
+	if self.dense != nil {
+		self.dense[sym] = node
+		return
+	}
+	if i, found := self.sparseSearch(sym); found {
+		self.sparse[i].node = node
+		return
+	}
+	if len(self.sparse) >= denseChildThreshold {
+		self.promote()
+		self.dense[sym] = node
+		return
+	}
+	i, _ := self.sparseSearch(sym)
+	self.sparse = append(self.sparse, childEntry[TKey, TValue]{})
+	copy(self.sparse[i+1:], self.sparse[i:])
+	self.sparse[i] = childEntry[TKey, TValue]{sym: sym, node: node}
+}
+
+func (self *childSet[TKey, TValue]) delete(sym TKey) {
+	if self.dense != nil {
+		delete(self.dense, sym)
+		if len(self.dense) <= denseChildThreshold {
+			self.demote()
+		}
+		return
+	}
+	if i, found := self.sparseSearch(sym); found {
+		self.sparse = append(self.sparse[:i], self.sparse[i+1:]...)
+	}
+}
+
+// forEach visits every (symbol, node) pair. Iteration order is unspecified,
+// matching the unordered nature of the dense map mode.
+func (self *childSet[TKey, TValue]) forEach(visit func(sym TKey, node *TrieNode[TKey, TValue])) {
+	if self.dense != nil {
+		for sym, node := range self.dense {
+			visit(sym, node)
+		}
+		return
+	}
+	for _, entry := range self.sparse {
+		visit(entry.sym, entry.node)
+	}
+}
+
+// forEachUntil visits (symbol, node) pairs like forEach, but stops as soon
+// as visit returns false, letting callers short-circuit a traversal instead
+// of always walking every child.
+func (self *childSet[TKey, TValue]) forEachUntil(visit func(sym TKey, node *TrieNode[TKey, TValue]) bool) {
+	if self.dense != nil {
+		for sym, node := range self.dense {
+			if !visit(sym, node) {
+				return
+			}
+		}
+		return
+	}
+	for _, entry := range self.sparse {
+		if !visit(entry.sym, entry.node) {
+			return
+		}
+	}
+}
+
+// promote converts a sparse child set into a dense, map-backed one.
+func (self *childSet[TKey, TValue]) promote() {
+	self.dense = make(map[TKey]*TrieNode[TKey, TValue], len(self.sparse)*2)
+	for _, entry := range self.sparse {
+		self.dense[entry.sym] = entry.node
+	}
+	self.sparse = nil
+}
+
+// demote converts a dense, map-backed child set back into a sorted sparse
+// slice once deletions have shrunk its branching factor back down to
+// denseChildThreshold, so a node that was briefly a hub doesn't keep paying
+// map overhead for the rest of its life.
+func (self *childSet[TKey, TValue]) demote() {
+	self.sparse = make([]childEntry[TKey, TValue], 0, len(self.dense))
+	for sym, node := range self.dense {
+		self.sparse = append(self.sparse, childEntry[TKey, TValue]{sym: sym, node: node})
+	}
+	slices.SortFunc(self.sparse, func(a, b childEntry[TKey, TValue]) int {
+		return cmp.Compare(a.sym, b.sym)
+	})
+	self.dense = nil
+}
+
+// Represents a node within a radix-compressed Trie. It is generic over the
+// symbol alphabet (TKey) and the value it stores (TValue). Unlike a classic
+// trie, a node owns a (possibly multi-symbol) prefix chunk shared by all of
+// its descendants, rather than a single symbol; the parent's childSet only
+// needs to be keyed on the first symbol of that chunk.
+type TrieNode[TKey cmp.Ordered, TValue any] struct {
+	// prefix is the chunk of the key consumed along the edge leading to
+	// this node. The root's prefix is always empty.
+	prefix []TKey
+
+	children childSet[TKey, TValue]
 
 	// The value associated with the full word ending at this node.
 	// Using value is key, as intermediate nodes won't have a value.
 	value *TValue
+
+	// version stamps which generation of a ConcurrentTrie last wrote this
+	// node. It is unused by the plain Trie; see ConcurrentTrie's
+	// copy-on-write Insert/Delete in concurrent.go.
+	version uint64
 }
 
-// Creates a new, "empty" TrieNode without an intitial value.
-// This is the correct constructor for a node that isn't the end of a word yet.
-func NewTrieNode[TValue any]() *TrieNode[TValue] {
-	return &TrieNode[TValue]{
-		children: make(map[rune]*TrieNode[TValue]),
-		value:    nil,
-	}
+// Creates a new, "empty" TrieNode without an intitial value or prefix.
+// This is the correct constructor for the root of a Trie.
+func NewTrieNode[TKey cmp.Ordered, TValue any]() *TrieNode[TKey, TValue] {
+	return &TrieNode[TKey, TValue]{}
+}
+
+// newTrieNodeWithPrefix creates a node that owns the given prefix chunk.
+func newTrieNodeWithPrefix[TKey cmp.Ordered, TValue any](prefix []TKey) *TrieNode[TKey, TValue] {
+	return &TrieNode[TKey, TValue]{prefix: prefix}
 }
 
 // Checks if the node has any children. A node with no children is a "leaf".
-func (self *TrieNode[TValue]) hasChildren() bool {
-	return len(self.children) > 0
+func (self *TrieNode[TKey, TValue]) hasChildren() bool {
+	return self.children.len() > 0
 }
 
-// checks if the node has the character as a child in the map. this allows the trie to ask questions
-// about the nodes children with out getting a mutable reference to the child.
-func (self *TrieNode[TValue]) containsChild(char rune) bool {
-	_, exists := self.children[char]
-	return exists
+// checks if the node has a child edge starting with the symbol. this allows the trie to ask
+// questions about the nodes children with out getting a mutable reference to the child.
+func (self *TrieNode[TKey, TValue]) containsChild(sym TKey) bool {
+	return self.children.get(sym) != nil
 }
 
-// Gets an reference to a child node correspondiding to the character.
-func (self *TrieNode[TValue]) getChildMut(character rune) (*TrieNode[TValue], error) {
-	if _, exists := self.children[character]; !exists {
-		return nil, TrieErrorChildDoesNotExist
+// Gets a reference to the child node whose prefix begins with sym.
+func (self *TrieNode[TKey, TValue]) getChildMut(sym TKey) (*TrieNode[TKey, TValue], error) {
+	if child := self.children.get(sym); child != nil {
+		return child, nil
 	}
-	return self.children[character], nil
+	return nil, TrieErrorChildDoesNotExist
 }
 
-// Creates a new child node for the given character and returns a reference to that node.
-// If the child already exists, it simply returns a reference to the existing child.
-func (self *TrieNode[TValue]) addChild(character rune) *TrieNode[TValue] {
-	if _, exists := self.children[character]; !exists {
-		self.children[character] = NewTrieNode[TValue]()
-		return self.children[character]
+// Creates a new child node owning the given prefix chunk and returns it. If
+// an edge already exists for the chunk's first symbol, the existing child is
+// returned unchanged; callers that need to extend or split an existing edge
+// must do so explicitly rather than relying on addChild.
+func (self *TrieNode[TKey, TValue]) addChild(prefix []TKey) *TrieNode[TKey, TValue] {
+	if existing := self.children.get(prefix[0]); existing != nil {
+		return existing
 	}
-	return self.children[character]
+	child := newTrieNodeWithPrefix[TKey, TValue](prefix)
+	self.children.set(prefix[0], child)
+	return child
 }
 
 // Removes a child node. If the child does not exist, this is a no-op.
-func (self *TrieNode[TValue]) removeChild(character rune) {
-	delete(self.children, character)
+func (self *TrieNode[TKey, TValue]) removeChild(sym TKey) {
+	self.children.delete(sym)
 }
 
 // Checks if this node represents the end of a complete word.
-func (self *TrieNode[TValue]) isEndOfWord() bool {
+func (self *TrieNode[TKey, TValue]) isEndOfWord() bool {
 	return self.value != nil
 }
 
 // Sets the value for this node, marking it as the end of a word.
-func (self *TrieNode[TValue]) setValue(value TValue) {
+func (self *TrieNode[TKey, TValue]) setValue(value TValue) {
 	self.value = &value
 }
 
 // Gets the value associated with this node, if it is the end of a word.
-func (self *TrieNode[TValue]) getValue() (*TValue, bool) {
+func (self *TrieNode[TKey, TValue]) getValue() (*TValue, bool) {
 	if self.value != nil {
 		return self.value, true
 	}
@@ -74,6 +246,6 @@ func (self *TrieNode[TValue]) getValue() (*TValue, bool) {
 }
 
 // Clears the value on the node, if it is the end of a word.
-func (self *TrieNode[TValue]) clearValue() {
+func (self *TrieNode[TKey, TValue]) clearValue() {
 	self.value = nil
 }
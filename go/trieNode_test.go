@@ -5,7 +5,7 @@ import (
 )
 
 func TestNewTrieNode(t *testing.T) {
-	node := NewTrieNode[string]()
+	node := NewTrieNode[rune, string]()
 
 	if node == nil {
 		t.Fatal("NewTrieNode should not return nil")
@@ -15,12 +15,8 @@ func TestNewTrieNode(t *testing.T) {
 		t.Error("New node should have nil value")
 	}
 
-	if node.children == nil {
-		t.Error("New node should have initialized children map")
-	}
-
-	if len(node.children) != 0 {
-		t.Error("New node should have empty children map")
+	if node.prefix != nil {
+		t.Error("New node should have a nil prefix")
 	}
 
 	if node.hasChildren() {
@@ -33,7 +29,7 @@ func TestNewTrieNode(t *testing.T) {
 }
 
 func TestSetValue(t *testing.T) {
-	node := NewTrieNode[string]()
+	node := NewTrieNode[rune, string]()
 
 	// Initially not end of word
 	if node.isEndOfWord() {
@@ -58,7 +54,7 @@ func TestSetValue(t *testing.T) {
 }
 
 func TestClearValue(t *testing.T) {
-	node := NewTrieNode[int]()
+	node := NewTrieNode[rune, int]()
 
 	// Set a value first
 	testValue := 42
@@ -81,16 +77,15 @@ func TestClearValue(t *testing.T) {
 }
 
 func TestAddChild(t *testing.T) {
-	node := NewTrieNode[string]()
+	node := NewTrieNode[rune, string]()
 
 	// Initially no children
 	if node.hasChildren() {
 		t.Error("Node should not have children initially")
 	}
 
-	// Add a child
-	char := 'a'
-	child := node.addChild(char)
+	// Add a child keyed on its first rune
+	child := node.addChild([]rune("a"))
 
 	if child == nil {
 		t.Fatal("addChild should return a valid node")
@@ -100,37 +95,35 @@ func TestAddChild(t *testing.T) {
 		t.Error("Node should have children after adding one")
 	}
 
-	if !node.containsChild(char) {
+	if !node.containsChild('a') {
 		t.Error("Node should contain the added child")
 	}
 
-	// Add the same child again - should return existing
-	child2 := node.addChild(char)
+	// Adding again for the same leading rune should return the existing child.
+	child2 := node.addChild([]rune("a"))
 
 	if child != child2 {
-		t.Error("Adding same child twice should return the same node")
+		t.Error("Adding a child for an existing edge twice should return the same node")
 	}
 
-	if len(node.children) != 1 {
-		t.Errorf("Expected 1 child, got %d", len(node.children))
+	if node.children.len() != 1 {
+		t.Errorf("Expected 1 child, got %d", node.children.len())
 	}
 }
 
 func TestContainsChild(t *testing.T) {
-	node := NewTrieNode[string]()
-
-	char := 'b'
+	node := NewTrieNode[rune, string]()
 
 	// Initially should not contain any children
-	if node.containsChild(char) {
+	if node.containsChild('b') {
 		t.Error("Node should not contain child initially")
 	}
 
 	// Add child
-	node.addChild(char)
+	node.addChild([]rune("b"))
 
 	// Now should contain the child
-	if !node.containsChild(char) {
+	if !node.containsChild('b') {
 		t.Error("Node should contain child after adding")
 	}
 
@@ -141,7 +134,7 @@ func TestContainsChild(t *testing.T) {
 }
 
 func TestGetChildMut(t *testing.T) {
-	node := NewTrieNode[string]()
+	node := NewTrieNode[rune, string]()
 
 	char := 'c'
 
@@ -157,7 +150,7 @@ func TestGetChildMut(t *testing.T) {
 	}
 
 	// Add child and then get it
-	originalChild := node.addChild(char)
+	originalChild := node.addChild([]rune("c"))
 	retrievedChild, err := node.getChildMut(char)
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
@@ -169,7 +162,7 @@ func TestGetChildMut(t *testing.T) {
 }
 
 func TestRemoveChild(t *testing.T) {
-	node := NewTrieNode[string]()
+	node := NewTrieNode[rune, string]()
 
 	char := 'd'
 
@@ -181,7 +174,7 @@ func TestRemoveChild(t *testing.T) {
 	}
 
 	// Add child, then remove it
-	node.addChild(char)
+	node.addChild([]rune("d"))
 
 	if !node.hasChildren() {
 		t.Error("Node should have children after adding")
@@ -204,13 +197,13 @@ func TestRemoveChild(t *testing.T) {
 }
 
 func TestMultipleChildren(t *testing.T) {
-	node := NewTrieNode[int]()
+	node := NewTrieNode[rune, int]()
 
-	chars := []rune{'a', 'b', 'c', 'ðŸŒŸ'} // Include Unicode
+	chars := []rune{'a', 'b', 'c', '🌟'} // Include Unicode
 
 	// Add multiple children
 	for _, char := range chars {
-		child := node.addChild(char)
+		child := node.addChild([]rune{char})
 		if child == nil {
 			t.Fatalf("Failed to add child for character %c", char)
 		}
@@ -231,8 +224,8 @@ func TestMultipleChildren(t *testing.T) {
 		}
 	}
 
-	if len(node.children) != len(chars) {
-		t.Errorf("Expected %d children, got %d", len(chars), len(node.children))
+	if node.children.len() != len(chars) {
+		t.Errorf("Expected %d children, got %d", len(chars), node.children.len())
 	}
 
 	// Remove one child
@@ -242,8 +235,8 @@ func TestMultipleChildren(t *testing.T) {
 		t.Errorf("Node should not contain removed child %c", chars[0])
 	}
 
-	if len(node.children) != len(chars)-1 {
-		t.Errorf("Expected %d children after removal, got %d", len(chars)-1, len(node.children))
+	if node.children.len() != len(chars)-1 {
+		t.Errorf("Expected %d children after removal, got %d", len(chars)-1, node.children.len())
 	}
 
 	// Other children should still exist
@@ -255,12 +248,12 @@ func TestMultipleChildren(t *testing.T) {
 }
 
 func TestUnicodeSupport(t *testing.T) {
-	node := NewTrieNode[string]()
+	node := NewTrieNode[rune, string]()
 
-	unicodeChars := []rune{'ðŸŒŸ', 'ä½ ', 'Ã±', 'Ã¼', 'ðŸš€'}
+	unicodeChars := []rune{'🌟', '你', 'ñ', 'ü', '🚀'}
 
 	for _, char := range unicodeChars {
-		child := node.addChild(char)
+		child := node.addChild([]rune{char})
 		if child == nil {
 			t.Fatalf("Failed to add Unicode child %c", char)
 		}
@@ -280,7 +273,7 @@ func TestUnicodeSupport(t *testing.T) {
 }
 
 func TestNodeStateTransitions(t *testing.T) {
-	node := NewTrieNode[string]()
+	node := NewTrieNode[rune, string]()
 
 	// Start state: no children, no value
 	if node.hasChildren() || node.isEndOfWord() {
@@ -288,7 +281,7 @@ func TestNodeStateTransitions(t *testing.T) {
 	}
 
 	// Add child: has children, no value
-	node.addChild('a')
+	node.addChild([]rune("a"))
 	if !node.hasChildren() || node.isEndOfWord() {
 		t.Error("After adding child: should have children, not end of word")
 	}
@@ -311,3 +304,90 @@ func TestNodeStateTransitions(t *testing.T) {
 		t.Error("After removing child: should not have children, not end of word")
 	}
 }
+
+func TestChildSetSparseStaysSorted(t *testing.T) {
+	node := NewTrieNode[rune, int]()
+
+	chars := []rune{'f', 'b', 'z', 'a', 'm'} // inserted out of order
+	for _, char := range chars {
+		node.addChild([]rune{char})
+	}
+
+	if node.children.dense != nil {
+		t.Fatal("5 children should stay in sparse mode")
+	}
+
+	for i := 1; i < len(node.children.sparse); i++ {
+		if node.children.sparse[i-1].sym >= node.children.sparse[i].sym {
+			t.Errorf("sparse children should be sorted by char, got %v", node.children.sparse)
+			break
+		}
+	}
+
+	for _, char := range chars {
+		if !node.containsChild(char) {
+			t.Errorf("sorted sparse set should still find child %c", char)
+		}
+	}
+
+	node.removeChild('b')
+	if node.containsChild('b') {
+		t.Error("removed child should no longer be found")
+	}
+	for i := 1; i < len(node.children.sparse); i++ {
+		if node.children.sparse[i-1].sym >= node.children.sparse[i].sym {
+			t.Errorf("sparse children should stay sorted after a removal, got %v", node.children.sparse)
+			break
+		}
+	}
+}
+
+func TestChildSetPromotesToDense(t *testing.T) {
+	node := NewTrieNode[rune, int]()
+
+	chars := []rune("abcdefghij") // 10 > denseChildThreshold
+	for _, char := range chars {
+		node.addChild([]rune{char})
+	}
+
+	if node.children.dense == nil {
+		t.Error("childSet should promote to dense mode once denseChildThreshold is exceeded")
+	}
+
+	if node.children.len() != len(chars) {
+		t.Errorf("Expected %d children, got %d", len(chars), node.children.len())
+	}
+
+	for _, char := range chars {
+		if !node.containsChild(char) {
+			t.Errorf("Dense child set should still contain %c", char)
+		}
+	}
+}
+
+func TestChildSetDemotesToSparse(t *testing.T) {
+	node := NewTrieNode[rune, int]()
+
+	chars := []rune("abcdefghij") // 10 > denseChildThreshold
+	for _, char := range chars {
+		node.addChild([]rune{char})
+	}
+
+	if node.children.dense == nil {
+		t.Fatal("childSet should promote to dense mode once denseChildThreshold is exceeded")
+	}
+
+	for _, char := range chars[:8] { // shrink back down to 2 children
+		node.removeChild(char)
+	}
+
+	if node.children.dense != nil {
+		t.Error("childSet should demote back to sparse mode once its branching factor drops to denseChildThreshold")
+	}
+
+	for _, char := range chars[8:] {
+		if !node.containsChild(char) {
+			t.Errorf("Sparse child set should still contain %c", char)
+		}
+	}
+}
@@ -7,16 +7,9 @@ import (
 	"testing"
 )
 
-// Constructor for Trie - you'll need this
-func NewTrie[TValue any]() *Trie[TValue] {
-	return &Trie[TValue]{
-		root: NewTrieNode[TValue](),
-	}
-}
-
 // Basic functionality tests
 func TestNewTrie(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	if trie == nil {
 		t.Fatal("NewTrie should not return nil")
@@ -36,15 +29,15 @@ func TestNewTrie(t *testing.T) {
 }
 
 func TestInsertAndGet(t *testing.T) {
-	trie := NewTrie[int]()
+	trie := NewStringTrie[int]()
 
 	// Test inserting and getting a single word
 	key := "apple"
 	value := 42
 
-	trie.Insert(&key, value)
+	InsertString(trie, key, value)
 
-	retrievedValue, exists := trie.Get(&key)
+	retrievedValue, exists := GetString(trie, key)
 	if !exists {
 		t.Error("Key should exist after insertion")
 	}
@@ -59,10 +52,10 @@ func TestInsertAndGet(t *testing.T) {
 }
 
 func TestGetNonExistent(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	key := "nonexistent"
-	value, exists := trie.Get(&key)
+	value, exists := GetString(trie, key)
 
 	if exists {
 		t.Error("Non-existent key should not exist")
@@ -74,7 +67,7 @@ func TestGetNonExistent(t *testing.T) {
 }
 
 func TestInsertMultipleWords(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	words := map[string]string{
 		"cat":      "feline",
@@ -87,12 +80,12 @@ func TestInsertMultipleWords(t *testing.T) {
 
 	// Insert all words
 	for key, value := range words {
-		trie.Insert(&key, value)
+		InsertString(trie, key, value)
 	}
 
 	// Verify all words exist
 	for key, expectedValue := range words {
-		value, exists := trie.Get(&key)
+		value, exists := GetString(trie, key)
 		if !exists {
 			t.Errorf("Key %s should exist", key)
 			continue
@@ -110,24 +103,24 @@ func TestInsertMultipleWords(t *testing.T) {
 }
 
 func TestInsertUpdateExisting(t *testing.T) {
-	trie := NewTrie[int]()
+	trie := NewStringTrie[int]()
 
 	key := "test"
 	originalValue := 1
 	newValue := 2
 
 	// Insert original value
-	trie.Insert(&key, originalValue)
+	InsertString(trie, key, originalValue)
 
-	value, _ := trie.Get(&key)
+	value, _ := GetString(trie, key)
 	if *value != originalValue {
 		t.Errorf("Expected original value %d", originalValue)
 	}
 
 	// Update with new value
-	trie.Insert(&key, newValue)
+	InsertString(trie, key, newValue)
 
-	value, exists := trie.Get(&key)
+	value, exists := GetString(trie, key)
 	if !exists {
 		t.Error("Key should still exist after update")
 	}
@@ -139,30 +132,30 @@ func TestInsertUpdateExisting(t *testing.T) {
 
 // Delete tests
 func TestDelete(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	// Insert some words
 	words := []string{"cat", "cats", "car"}
 	for _, word := range words {
-		trie.Insert(&word, word+"_value")
+		InsertString(trie, word, word+"_value")
 	}
 
 	// Delete "cat"
 	key := "cat"
-	deleted := trie.Delete(&key)
+	deleted := DeleteString(trie, key)
 	if !deleted {
 		t.Error("Delete should return true for existing key")
 	}
 
 	// Verify "cat" is gone
-	_, exists := trie.Get(&key)
+	_, exists := GetString(trie, key)
 	if exists {
 		t.Error("Deleted key should not exist")
 	}
 
 	// Verify "cats" and "car" still exist
 	for _, word := range []string{"cats", "car"} {
-		_, exists := trie.Get(&word)
+		_, exists := GetString(trie, word)
 		if !exists {
 			t.Errorf("Key %s should still exist after deleting %s", word, key)
 		}
@@ -170,10 +163,10 @@ func TestDelete(t *testing.T) {
 }
 
 func TestDeleteNonExistent(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	key := "nonexistent"
-	deleted := trie.Delete(&key)
+	deleted := DeleteString(trie, key)
 
 	if deleted {
 		t.Error("Delete should return false for non-existent key")
@@ -181,10 +174,10 @@ func TestDeleteNonExistent(t *testing.T) {
 }
 
 func TestDeleteEmptyKey(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	emptyKey := ""
-	deleted := trie.Delete(&emptyKey)
+	deleted := DeleteString(trie, emptyKey)
 
 	if deleted {
 		t.Error("Delete should return false for empty key")
@@ -198,7 +191,7 @@ func TestDeleteEmptyKey(t *testing.T) {
 }
 
 func TestDeleteComplexScenario(t *testing.T) {
-	trie := NewTrie[int]()
+	trie := NewStringTrie[int]()
 
 	// Insert words where one is prefix of another
 	words := map[string]int{
@@ -209,22 +202,22 @@ func TestDeleteComplexScenario(t *testing.T) {
 	}
 
 	for key, value := range words {
-		trie.Insert(&key, value)
+		InsertString(trie, key, value)
 	}
 
 	// Delete "app" - should not affect others
 	key := "app"
-	trie.Delete(&key)
+	DeleteString(trie, key)
 
 	// Verify "app" is gone
-	_, exists := trie.Get(&key)
+	_, exists := GetString(trie, key)
 	if exists {
 		t.Error("'app' should be deleted")
 	}
 
 	// Verify others still exist
 	for _, word := range []string{"apple", "apply", "application"} {
-		_, exists := trie.Get(&word)
+		_, exists := GetString(trie, word)
 		if !exists {
 			t.Errorf("'%s' should still exist", word)
 		}
@@ -232,15 +225,15 @@ func TestDeleteComplexScenario(t *testing.T) {
 
 	// Delete "apple" - should not affect "apply" or "application"
 	key = "apple"
-	trie.Delete(&key)
+	DeleteString(trie, key)
 
-	_, exists = trie.Get(&key)
+	_, exists = GetString(trie, key)
 	if exists {
 		t.Error("'apple' should be deleted")
 	}
 
 	for _, word := range []string{"apply", "application"} {
-		_, exists := trie.Get(&word)
+		_, exists := GetString(trie, word)
 		if !exists {
 			t.Errorf("'%s' should still exist after deleting apple", word)
 		}
@@ -248,14 +241,14 @@ func TestDeleteComplexScenario(t *testing.T) {
 }
 
 func TestDeletePruning(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	// Insert a word that creates a long chain
 	word := "testing"
-	trie.Insert(&word, "value")
+	InsertString(trie, word, "value")
 
 	// Delete it - should prune the entire unused branch
-	deleted := trie.Delete(&word)
+	deleted := DeleteString(trie, word)
 	if !deleted {
 		t.Error("Delete should succeed")
 	}
@@ -268,11 +261,11 @@ func TestDeletePruning(t *testing.T) {
 
 // Prefix search tests
 func TestPrefixSearch(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	words := []string{"cat", "cats", "car", "card", "care", "careful", "dog", "doggy"}
 	for _, word := range words {
-		trie.Insert(&word, word+"_value")
+		InsertString(trie, word, word+"_value")
 	}
 
 	testCases := []struct {
@@ -289,7 +282,7 @@ func TestPrefixSearch(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		results := trie.PrefixSearch(&tc.prefix)
+		results := PrefixSearchString(trie, tc.prefix)
 
 		// Sort both slices for comparison
 		sort.Strings(results)
@@ -302,10 +295,10 @@ func TestPrefixSearch(t *testing.T) {
 }
 
 func TestPrefixSearchEmpty(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	prefix := "anything"
-	results := trie.PrefixSearch(&prefix)
+	results := PrefixSearchString(trie, prefix)
 
 	if len(results) != 0 {
 		t.Errorf("Empty trie should return empty results, got %v", results)
@@ -313,15 +306,15 @@ func TestPrefixSearchEmpty(t *testing.T) {
 }
 
 func TestPrefixSearchEmptyPrefix(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	words := []string{"cat", "dog", "bird"}
 	for _, word := range words {
-		trie.Insert(&word, word+"_value")
+		InsertString(trie, word, word+"_value")
 	}
 
 	prefix := ""
-	results := trie.PrefixSearch(&prefix)
+	results := PrefixSearchString(trie, prefix)
 
 	// Empty prefix should return all words
 	sort.Strings(results)
@@ -333,16 +326,16 @@ func TestPrefixSearchEmptyPrefix(t *testing.T) {
 }
 
 func TestPrefixSearchWithPrefixAsWord(t *testing.T) {
-	trie := NewTrie[int]()
+	trie := NewStringTrie[int]()
 
 	// Insert words where some are prefixes of others
-	trie.Insert(stringPtr("car"), 1)
-	trie.Insert(stringPtr("care"), 2)
-	trie.Insert(stringPtr("careful"), 3)
-	trie.Insert(stringPtr("careless"), 4)
+	InsertString(trie, "car", 1)
+	InsertString(trie, "care", 2)
+	InsertString(trie, "careful", 3)
+	InsertString(trie, "careless", 4)
 
 	prefix := "care"
-	results := trie.PrefixSearch(&prefix)
+	results := PrefixSearchString(trie, prefix)
 	expected := []string{"care", "careful", "careless"}
 
 	sort.Strings(results)
@@ -353,20 +346,176 @@ func TestPrefixSearchWithPrefixAsWord(t *testing.T) {
 	}
 }
 
+// GetByPrefix tests
+func TestGetByPrefixEmptyTrie(t *testing.T) {
+	trie := NewStringTrie[int]()
+
+	prefix := "a1b2"
+	key, value, err := GetByPrefixString(trie, prefix)
+
+	if err != ErrPrefixNotFound {
+		t.Errorf("Expected ErrPrefixNotFound on empty trie, got %v", err)
+	}
+	if key != "" || value != nil {
+		t.Error("Expected empty key and nil value on empty trie")
+	}
+}
+
+func TestGetByPrefixEmptyPrefix(t *testing.T) {
+	trie := NewStringTrie[int]()
+
+	word := "a1b2c3"
+	InsertString(trie, word, 1)
+
+	prefix := ""
+	_, _, err := GetByPrefixString(trie, prefix)
+
+	if err != ErrEmptyPrefix {
+		t.Errorf("Expected ErrEmptyPrefix, got %v", err)
+	}
+}
+
+func TestGetByPrefixUnique(t *testing.T) {
+	trie := NewStringTrie[string]()
+
+	ids := map[string]string{
+		"a1b2c3d4": "container-1",
+		"b1c2d3e4": "container-2",
+	}
+	for id, name := range ids {
+		InsertString(trie, id, name)
+	}
+
+	prefix := "a1b2"
+	key, value, err := GetByPrefixString(trie, prefix)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if key != "a1b2c3d4" {
+		t.Errorf("Expected key a1b2c3d4, got %v", key)
+	}
+	if value == nil || *value != "container-1" {
+		t.Errorf("Expected value container-1, got %v", value)
+	}
+}
+
+func TestGetByPrefixExactMatchThatIsAlsoAPrefix(t *testing.T) {
+	trie := NewStringTrie[int]()
+
+	words := map[string]int{
+		"car":   1,
+		"cards": 2,
+	}
+	for word, value := range words {
+		InsertString(trie, word, value)
+	}
+
+	// "car" is both a complete key and a prefix of "cards": two keys share it.
+	prefix := "car"
+	_, _, err := GetByPrefixString(trie, prefix)
+
+	ambiguous, ok := err.(*ErrAmbiguousPrefix[rune])
+	if !ok {
+		t.Fatalf("Expected *ErrAmbiguousPrefix[rune], got %v", err)
+	}
+	if string(ambiguous.Prefix) != prefix {
+		t.Errorf("Expected ambiguous prefix %q, got %q", prefix, string(ambiguous.Prefix))
+	}
+
+	// An exact match that is not shared with any other key is unambiguous.
+	exact := "cards"
+	key, value, err := GetByPrefixString(trie, exact)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if key != "cards" {
+		t.Errorf("Expected key cards, got %v", key)
+	}
+	if value == nil || *value != 2 {
+		t.Errorf("Expected value 2, got %v", value)
+	}
+}
+
+func TestGetByPrefixAmbiguous(t *testing.T) {
+	trie := NewStringTrie[int]()
+
+	words := []string{"cat", "cats", "car"}
+	for i, word := range words {
+		InsertString(trie, word, i)
+	}
+
+	prefix := "ca"
+	_, _, err := GetByPrefixString(trie, prefix)
+
+	ambiguous, ok := err.(*ErrAmbiguousPrefix[rune])
+	if !ok {
+		t.Fatalf("Expected *ErrAmbiguousPrefix[rune], got %v", err)
+	}
+	if ambiguous.Matches < 2 {
+		t.Errorf("Expected at least 2 matches, got %d", ambiguous.Matches)
+	}
+}
+
+func TestGetByPrefixNonExistent(t *testing.T) {
+	trie := NewStringTrie[int]()
+
+	word := "apple"
+	InsertString(trie, word, 1)
+
+	prefix := "banana"
+	_, _, err := GetByPrefixString(trie, prefix)
+
+	if err != ErrPrefixNotFound {
+		t.Errorf("Expected ErrPrefixNotFound, got %v", err)
+	}
+}
+
+func TestGetByPrefixUnicode(t *testing.T) {
+	trie := NewStringTrie[string]()
+
+	words := map[string]string{
+		"café":   "coffee",
+		"caméra": "camera",
+		"naïve":  "innocent",
+	}
+	for word, value := range words {
+		InsertString(trie, word, value)
+	}
+
+	prefix := "café"
+	key, value, err := GetByPrefixString(trie, prefix)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if key != "café" {
+		t.Errorf("Expected key café, got %v", key)
+	}
+	if value == nil || *value != "coffee" {
+		t.Errorf("Expected value coffee, got %v", value)
+	}
+
+	ambiguousPrefix := "ca"
+	_, _, err = GetByPrefixString(trie, ambiguousPrefix)
+	if _, ok := err.(*ErrAmbiguousPrefix[rune]); !ok {
+		t.Fatalf("Expected *ErrAmbiguousPrefix[rune] for shared unicode prefix, got %v", err)
+	}
+}
+
 // AddWordList tests
 func TestAddWordList(t *testing.T) {
-	trie := NewTrie[int]()
+	trie := NewStringTrie[int]()
 
 	words := []string{"apple", "banana", "cherry"}
 	valueGenerator := func(word string) int {
 		return len(word) // Use word length as value
 	}
 
-	trie.AddWordList(&words, valueGenerator)
+	AddWordList(trie, words, valueGenerator)
 
 	// Verify all words were added with correct values
 	for _, word := range words {
-		value, exists := trie.Get(&word)
+		value, exists := GetString(trie, word)
 		if !exists {
 			t.Errorf("Word %s should exist after AddWordList", word)
 			continue
@@ -380,18 +529,18 @@ func TestAddWordList(t *testing.T) {
 }
 
 func TestAddWordListWithSameValue(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	words := []string{"red", "green", "blue"}
 	constantValue := "color"
 
-	trie.AddWordList(&words, func(word string) string {
+	AddWordList(trie, words, func(word string) string {
 		return constantValue
 	})
 
 	// All words should have the same value
 	for _, word := range words {
-		value, exists := trie.Get(&word)
+		value, exists := GetString(trie, word)
 		if !exists {
 			t.Errorf("Word %s should exist", word)
 			continue
@@ -405,7 +554,7 @@ func TestAddWordListWithSameValue(t *testing.T) {
 
 // Unicode and special character tests
 func TestUnicodeSupportTrie(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	unicodeWords := map[string]string{
 		"caf√©":    "coffee",
@@ -420,12 +569,12 @@ func TestUnicodeSupportTrie(t *testing.T) {
 
 	// Insert unicode words
 	for key, value := range unicodeWords {
-		trie.Insert(&key, value)
+		InsertString(trie, key, value)
 	}
 
 	// Verify they can be retrieved
 	for key, expectedValue := range unicodeWords {
-		value, exists := trie.Get(&key)
+		value, exists := GetString(trie, key)
 		if !exists {
 			t.Errorf("Unicode word %s should exist", key)
 			continue
@@ -438,7 +587,7 @@ func TestUnicodeSupportTrie(t *testing.T) {
 
 	// Test prefix search with unicode
 	prefix := "caf"
-	results := trie.PrefixSearch(&prefix)
+	results := PrefixSearchString(trie, prefix)
 	expected := []string{"caf√©"}
 
 	if !reflect.DeepEqual(results, expected) {
@@ -447,7 +596,7 @@ func TestUnicodeSupportTrie(t *testing.T) {
 }
 
 func TestEmojiSupport(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	emojis := map[string]string{
 		"üöÄ":  "rocket",
@@ -458,11 +607,11 @@ func TestEmojiSupport(t *testing.T) {
 	}
 
 	for emoji, meaning := range emojis {
-		trie.Insert(&emoji, meaning)
+		InsertString(trie, emoji, meaning)
 	}
 
 	for emoji, expectedMeaning := range emojis {
-		value, exists := trie.Get(&emoji)
+		value, exists := GetString(trie, emoji)
 		if !exists {
 			t.Errorf("Emoji %s should exist", emoji)
 			continue
@@ -476,13 +625,13 @@ func TestEmojiSupport(t *testing.T) {
 
 // Edge cases and stress tests
 func TestEdgeCases(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	// Test single character words
 	singleChar := "a"
-	trie.Insert(&singleChar, "letter_a")
+	InsertString(trie, singleChar, "letter_a")
 
-	value, exists := trie.Get(&singleChar)
+	value, exists := GetString(trie, singleChar)
 	if !exists || value == nil || *value != "letter_a" {
 		t.Error("Single character word should work")
 	}
@@ -494,23 +643,23 @@ func TestEdgeCases(t *testing.T) {
 	}
 	longWord := string(longWordRunes)
 
-	trie.Insert(&longWord, "very_long")
+	InsertString(trie, longWord, "very_long")
 
-	value, exists = trie.Get(&longWord)
+	value, exists = GetString(trie, longWord)
 	if !exists || value == nil || *value != "very_long" {
 		t.Error("Very long word should work")
 	}
 }
 
 func TestEmptyStringHandling(t *testing.T) {
-	trie := NewTrie[string]()
+	trie := NewStringTrie[string]()
 
 	// Insert empty string
 	emptyKey := ""
-	trie.Insert(&emptyKey, "empty_value")
+	InsertString(trie, emptyKey, "empty_value")
 
 	// Should be able to retrieve it
-	value, exists := trie.Get(&emptyKey)
+	value, exists := GetString(trie, emptyKey)
 	if !exists {
 		t.Error("Empty string should be insertable and retrievable")
 	}
@@ -520,7 +669,7 @@ func TestEmptyStringHandling(t *testing.T) {
 	}
 
 	// Test prefix search with empty string
-	results := trie.PrefixSearch(&emptyKey)
+	results := PrefixSearchString(trie, emptyKey)
 	if len(results) == 0 {
 		t.Error("Prefix search with empty string should return something if trie has words")
 	}
@@ -539,19 +688,19 @@ func TestEmptyStringHandling(t *testing.T) {
 }
 
 func TestLargeDataset(t *testing.T) {
-	trie := NewTrie[int]()
+	trie := NewStringTrie[int]()
 
 	// Generate a large number of words
 	const numWords = 1000
 	words := make([]string, numWords)
 	for i := 0; i < numWords; i++ {
 		words[i] = fmt.Sprintf("word_%d", i)
-		trie.Insert(&words[i], i)
+		InsertString(trie, words[i], i)
 	}
 
 	// Verify all words exist
 	for i, word := range words {
-		value, exists := trie.Get(&word)
+		value, exists := GetString(trie, word)
 		if !exists {
 			t.Errorf("Word %s (index %d) should exist", word, i)
 			continue
@@ -564,7 +713,7 @@ func TestLargeDataset(t *testing.T) {
 
 	// Test prefix search on large dataset
 	prefix := "word_1"
-	results := trie.PrefixSearch(&prefix)
+	results := PrefixSearchString(trie, prefix)
 
 	// Should find words like "word_1", "word_10", "word_11", ..., "word_199"
 	if len(results) == 0 {
@@ -580,18 +729,19 @@ func TestLargeDataset(t *testing.T) {
 }
 
 func TestConcurrentOperations(t *testing.T) {
-	// Note: This test assumes your implementation is NOT thread-safe
-	// If you want thread safety, you'd need to add mutexes
-	trie := NewTrie[int]()
+	// Note: Trie itself is still not safe for concurrent use - only
+	// sequential access is exercised here. See ConcurrentTrie for a
+	// mutex-guarded wrapper with snapshot support.
+	trie := NewStringTrie[int]()
 
 	// Sequential operations should work fine
 	words := []string{"test1", "test2", "test3"}
 	for i, word := range words {
-		trie.Insert(&word, i)
+		InsertString(trie, word, i)
 	}
 
 	for i, word := range words {
-		value, exists := trie.Get(&word)
+		value, exists := GetString(trie, word)
 		if !exists || value == nil || *value != i {
 			t.Errorf("Sequential operation failed for word %s", word)
 		}
@@ -600,48 +750,27 @@ func TestConcurrentOperations(t *testing.T) {
 
 // Performance benchmark (not a test, but useful)
 func BenchmarkInsert(b *testing.B) {
-	trie := NewTrie[int]()
+	trie := NewStringTrie[int]()
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		word := fmt.Sprintf("word_%d", i)
-		trie.Insert(&word, i)
+		InsertString(trie, word, i)
 	}
 }
 
 func BenchmarkGet(b *testing.B) {
-	trie := NewTrie[int]()
+	trie := NewStringTrie[int]()
 
 	// Pre-populate with some data
 	for i := 0; i < 1000; i++ {
 		word := fmt.Sprintf("word_%d", i)
-		trie.Insert(&word, i)
+		InsertString(trie, word, i)
 	}
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		word := fmt.Sprintf("word_%d", i%1000)
-		trie.Get(&word)
-	}
-}
-
-func BenchmarkPrefixSearch(b *testing.B) {
-	trie := NewTrie[int]()
-
-	// Pre-populate with some data
-	for i := 0; i < 1000; i++ {
-		word := fmt.Sprintf("prefix_%d", i)
-		trie.Insert(&word, i)
-	}
-
-	prefix := "prefix_"
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		trie.PrefixSearch(&prefix)
+		GetString(trie, word)
 	}
 }
-
-// Helper function
-func stringPtr(s string) *string {
-	return &s
-}
@@ -0,0 +1,121 @@
+package trie
+
+import "errors"
+
+// VisitorFunc is called once per matching key during a walk. Returning
+// SkipSubtree stops the walk from descending into that key's children
+// (siblings still run); returning StopWalk ends the walk entirely with no
+// error reported to the caller. Any other non-nil error aborts the walk and
+// is returned from Walk/WalkPrefix as-is.
+type VisitorFunc[TKey any, TValue any] func(key []TKey, value *TValue) error
+
+// SkipSubtree tells Walk/WalkPrefix to stop descending into the current
+// key's children, but continue walking its siblings.
+var SkipSubtree = errors.New("trie: skip subtree")
+
+// StopWalk tells Walk/WalkPrefix to terminate the entire walk immediately.
+// It is not propagated to the caller as an error.
+var StopWalk = errors.New("trie: stop walk")
+
+// Walk streams every key in the trie to visitor, in place of allocating a
+// [][]TKey the way PrefixSearch does. It's a thin wrapper over WalkPrefix
+// with an empty prefix.
+func (self *Trie[TKey, TValue]) Walk(visitor VisitorFunc[TKey, TValue]) error {
+	return self.WalkPrefix(nil, visitor)
+}
+
+// WalkPrefix streams every key starting with prefix to visitor. Useful for
+// bounded top-K autocomplete (stop early via StopWalk), existence checks,
+// or enumerating a huge trie without paying PrefixSearch's O(N) allocation.
+func (self *Trie[TKey, TValue]) WalkPrefix(prefix []TKey, visitor VisitorFunc[TKey, TValue]) error {
+	node, accumulated, ok := self.findPrefixNode(prefix)
+	if !ok {
+		return nil
+	}
+
+	if err := self.walkRecursive(node, accumulated, visitor); err != nil && err != StopWalk {
+		return err
+	}
+	return nil
+}
+
+// VisitPrefixes streams every key in the trie that is itself a prefix of
+// key, shortest first, to visitor. This is the longest-prefix-match shape
+// used by routing tables and similar lookups: a caller can keep overwriting
+// its "best match so far" as visitor is called and end up with the longest
+// one once the walk completes, or return StopWalk the moment it sees a
+// match it's satisfied with. Since this follows a single path down to key
+// rather than branching, SkipSubtree has no effect here.
+func (self *Trie[TKey, TValue]) VisitPrefixes(key []TKey, visitor VisitorFunc[TKey, TValue]) error {
+	node := self.root
+	accumulated := []TKey{}
+	remaining := key
+
+	for {
+		if node.isEndOfWord() {
+			switch err := visitor(accumulated, node.value); err {
+			case nil, SkipSubtree:
+				// continue descending toward key
+			case StopWalk:
+				return nil
+			default:
+				return err
+			}
+		}
+
+		if len(remaining) == 0 {
+			return nil
+		}
+
+		child, err := node.getChildMut(remaining[0])
+		if err != nil || len(remaining) < len(child.prefix) || !symbolsEqual(child.prefix, remaining[:len(child.prefix)]) {
+			return nil
+		}
+
+		accumulated = append(accumulated, child.prefix...)
+		remaining = remaining[len(child.prefix):]
+		node = child
+	}
+}
+
+func (self *Trie[TKey, TValue]) walkRecursive(node *TrieNode[TKey, TValue], currPrefix []TKey, visitor VisitorFunc[TKey, TValue]) error {
+	if node.isEndOfWord() {
+		switch err := visitor(currPrefix, node.value); err {
+		case nil:
+			// continue into children below
+		case SkipSubtree:
+			return nil
+		default:
+			return err
+		}
+	}
+
+	var walkErr error
+	node.children.forEachUntil(func(_ TKey, child *TrieNode[TKey, TValue]) bool {
+		walkErr = self.walkRecursive(child, append(append([]TKey(nil), currPrefix...), child.prefix...), visitor)
+		return walkErr == nil
+	})
+	return walkErr
+}
+
+// WalkString is Walk for a rune-keyed Trie, converting each key back to a
+// string before calling visitor.
+func WalkString[TValue any](t *Trie[rune, TValue], visitor func(key string, value *TValue) error) error {
+	return t.Walk(func(key []rune, value *TValue) error {
+		return visitor(string(key), value)
+	})
+}
+
+// WalkPrefixString is WalkPrefix for a rune-keyed Trie.
+func WalkPrefixString[TValue any](t *Trie[rune, TValue], prefix string, visitor func(key string, value *TValue) error) error {
+	return t.WalkPrefix([]rune(prefix), func(key []rune, value *TValue) error {
+		return visitor(string(key), value)
+	})
+}
+
+// VisitPrefixesString is VisitPrefixes for a rune-keyed Trie.
+func VisitPrefixesString[TValue any](t *Trie[rune, TValue], key string, visitor func(key string, value *TValue) error) error {
+	return t.VisitPrefixes([]rune(key), func(key []rune, value *TValue) error {
+		return visitor(string(key), value)
+	})
+}
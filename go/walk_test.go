@@ -0,0 +1,256 @@
+package trie
+
+import (
+	"errors"
+	"sort"
+	"testing"
+)
+
+func insertWalkFixture(trie *Trie[rune, int]) {
+	words := []string{"cat", "cats", "car", "card", "care", "careful", "dog", "doggy"}
+	for i, word := range words {
+		InsertString(trie, word, i)
+	}
+}
+
+func TestWalkVisitsEveryKey(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertWalkFixture(trie)
+
+	var visited []string
+	err := WalkString(trie, func(key string, _ *int) error {
+		visited = append(visited, key)
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	sort.Strings(visited)
+	expected := []string{"car", "card", "care", "careful", "cat", "cats", "dog", "doggy"}
+	sort.Strings(expected)
+
+	if len(visited) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, visited)
+	}
+	for i := range expected {
+		if visited[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected, visited)
+			break
+		}
+	}
+}
+
+func TestWalkPrefixOnlyVisitsMatches(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertWalkFixture(trie)
+
+	var visited []string
+	prefix := "car"
+	err := WalkPrefixString(trie, prefix, func(key string, _ *int) error {
+		visited = append(visited, key)
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	sort.Strings(visited)
+	expected := []string{"car", "card", "care", "careful"}
+	sort.Strings(expected)
+
+	if len(visited) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, visited)
+	}
+	for i := range expected {
+		if visited[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected, visited)
+			break
+		}
+	}
+}
+
+func TestWalkStopWalkEndsEarlyWithNoError(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertWalkFixture(trie)
+
+	count := 0
+	err := WalkString(trie, func(key string, _ *int) error {
+		count++
+		return StopWalk
+	})
+
+	if err != nil {
+		t.Errorf("StopWalk should not surface as an error, got %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected the walk to visit exactly one key before stopping, visited %d", count)
+	}
+}
+
+func TestWalkSkipSubtreeSkipsChildrenNotSiblings(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertWalkFixture(trie)
+
+	var visited []string
+	err := WalkString(trie, func(key string, _ *int) error {
+		visited = append(visited, key)
+		if key == "car" {
+			return SkipSubtree
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, key := range visited {
+		if key == "card" || key == "care" || key == "careful" {
+			t.Errorf("SkipSubtree at 'car' should have skipped its children, but visited %q", key)
+		}
+	}
+
+	foundDog := false
+	for _, key := range visited {
+		if key == "dog" {
+			foundDog = true
+		}
+	}
+	if !foundDog {
+		t.Error("SkipSubtree should not have prevented visiting sibling subtrees like 'dog'")
+	}
+}
+
+func TestWalkPropagatesVisitorErrors(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertWalkFixture(trie)
+
+	boom := errors.New("boom")
+	err := WalkString(trie, func(key string, _ *int) error {
+		return boom
+	})
+
+	if err != boom {
+		t.Errorf("Expected visitor error to propagate, got %v", err)
+	}
+}
+
+func TestWalkPrefixBoundedTopK(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertWalkFixture(trie)
+
+	const k = 2
+	var results []string
+	prefix := "ca"
+	WalkPrefixString(trie, prefix, func(key string, _ *int) error {
+		results = append(results, key)
+		if len(results) >= k {
+			return StopWalk
+		}
+		return nil
+	})
+
+	if len(results) != k {
+		t.Errorf("Expected exactly %d results, got %v", k, results)
+	}
+}
+
+func TestVisitPrefixesLongestPrefixMatch(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertWalkFixture(trie)
+
+	var visited []string
+	key := "cards"
+	err := VisitPrefixesString(trie, key, func(k string, _ *int) error {
+		visited = append(visited, k)
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []string{"car", "card"}
+	if len(visited) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, visited)
+	}
+	for i := range expected {
+		if visited[i] != expected[i] {
+			t.Errorf("Expected %v in order, got %v", expected, visited)
+			break
+		}
+	}
+}
+
+func TestVisitPrefixesNoMatches(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertWalkFixture(trie)
+
+	visited := 0
+	key := "xyz"
+	err := VisitPrefixesString(trie, key, func(_ string, _ *int) error {
+		visited++
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if visited != 0 {
+		t.Errorf("Expected no visits, got %d", visited)
+	}
+}
+
+func TestVisitPrefixesExactKeyIsIncluded(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertWalkFixture(trie)
+
+	var visited []string
+	key := "car"
+	VisitPrefixesString(trie, key, func(k string, _ *int) error {
+		visited = append(visited, k)
+		return nil
+	})
+
+	if len(visited) != 1 || visited[0] != "car" {
+		t.Errorf("Expected only the exact key itself, got %v", visited)
+	}
+}
+
+func TestVisitPrefixesStopsEarly(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertWalkFixture(trie)
+
+	var visited []string
+	key := "careful"
+	VisitPrefixesString(trie, key, func(k string, _ *int) error {
+		visited = append(visited, k)
+		return StopWalk
+	})
+
+	if len(visited) != 1 || visited[0] != "car" {
+		t.Errorf("Expected to stop after the first (shortest) match, got %v", visited)
+	}
+}
+
+func TestWalkPrefixNonExistentPrefix(t *testing.T) {
+	trie := NewStringTrie[int]()
+	insertWalkFixture(trie)
+
+	visited := 0
+	prefix := "xyz"
+	err := WalkPrefixString(trie, prefix, func(_ string, _ *int) error {
+		visited++
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("Expected no error for non-existent prefix, got %v", err)
+	}
+	if visited != 0 {
+		t.Errorf("Expected no visits for non-existent prefix, got %d", visited)
+	}
+}